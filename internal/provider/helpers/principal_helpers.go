@@ -0,0 +1,69 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+const openApiEndpointGroups = "1.0.0/groups/"
+
+// openApiGroup holds the fields of an Organization group that are needed to look it up
+type openApiGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// CheckOrgUserExists checks that a user with the given name exists in the identity source of the
+// Organization of the session
+func CheckOrgUserExists(tmClient *vcfa.VCDClient, userName string) error {
+	if _, err := tmClient.GetUserByName(userName, &govcd.TenantContext{}); err != nil {
+		if govcd.ContainsNotFound(err) {
+			return fmt.Errorf("user %s not found in organization %s", userName, tmClient.Org)
+		}
+		return fmt.Errorf("error getting user %s in organization %s: %s", userName, tmClient.Org, err)
+	}
+	return nil
+}
+
+// CheckOrgGroupExists checks that a group with the given name exists in the identity source of the
+// Organization of the session
+func CheckOrgGroupExists(tmClient *vcfa.VCDClient, groupName string) error {
+	groupsURL, err := tmClient.Client.OpenApiBuildEndpoint(openApiEndpointGroups)
+	if err != nil {
+		return fmt.Errorf("error building groups URL: %s", err)
+	}
+
+	var groups []*openApiGroup
+	if err := tmClient.Client.OpenApiGetAllItems(tmClient.Client.APIVersion, groupsURL, getOrgGroupQueryParams(groupName), &groups, nil); err != nil {
+		return fmt.Errorf("error getting group %s in organization %s: %s", groupName, tmClient.Org, err)
+	}
+	for _, group := range groups {
+		if group.Name == groupName {
+			return nil
+		}
+	}
+	return fmt.Errorf("group %s not found in organization %s", groupName, tmClient.Org)
+}
+
+// getOrgGroupQueryParams returns the query parameters to look up the group with the given name. The name is
+// encoded in the FIQL filter. Like go-vcloud-director does, names with commas, semicolons, spaces, plus signs or
+// asterisks are not filtered at all, as VCFA rejects them even when encoded, so every group must be retrieved and
+// compared by name.
+func getOrgGroupQueryParams(groupName string) url.Values {
+	queryParams := url.Values{}
+	if strings.ContainsAny(groupName, ",; +*") {
+		return queryParams
+	}
+	queryParams.Add("filter", "name=="+url.QueryEscape(groupName))
+	queryParams.Add("filterEncoded", "true")
+	return queryParams
+}
@@ -0,0 +1,38 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import "testing"
+
+func TestGetOrgGroupQueryParams(t *testing.T) {
+	type testCase struct {
+		groupName      string
+		expectedFilter string
+	}
+
+	testCases := []testCase{
+		{groupName: "developers", expectedFilter: "name==developers"},
+		{groupName: "dev(ops)==admins", expectedFilter: "name==dev%28ops%29%3D%3Dadmins"},
+		{groupName: "cn=devs@example.com", expectedFilter: "name==cn%3Ddevs%40example.com"},
+		{groupName: "devs,admins"},
+		{groupName: "devs;name==admins"},
+		{groupName: "dev team"},
+		{groupName: "dev*"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.groupName, func(t *testing.T) {
+			queryParams := getOrgGroupQueryParams(tc.groupName)
+			if got := queryParams.Get("filter"); got != tc.expectedFilter {
+				t.Errorf("expected filter %q, got %q", tc.expectedFilter, got)
+			}
+			if tc.expectedFilter != "" && queryParams.Get("filterEncoded") != "true" {
+				t.Errorf("expected filterEncoded to be set")
+			}
+		})
+	}
+}
@@ -6,10 +6,12 @@ package helpers
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/vmware/go-vcloud-director/v3/ccitypes"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
 	"github.com/vmware/terraform-provider-vcfa/vcfa"
 )
 
@@ -23,10 +25,119 @@ func GetProject(tmClient *vcfa.VCDClient, projectName string) (ccitypes.Project,
 
 	if err := tmClient.VCDClient.Client.GetEntity(projectURL, nil, &project, nil); err != nil {
 		if govcd.ContainsNotFound(err) {
-			return project, fmt.Errorf("project %s not found", projectName)
+			return project, fmt.Errorf("project %s not found: %w", projectName, err)
 		}
 		return project, fmt.Errorf("error getting project %s: %s", projectName, err.Error())
 	}
 
 	return project, nil
 }
+
+// CreateProject creates the given Project in the Organization of the session
+func CreateProject(tmClient *vcfa.VCDClient, project ccitypes.Project) (ccitypes.Project, error) {
+	var projectOut ccitypes.Project
+
+	projectsURL, err := tmClient.VCDClient.Client.GetEntityUrl(ccitypes.ProjectsURL)
+	if err != nil {
+		return projectOut, fmt.Errorf("error getting project URL: %s", err)
+	}
+
+	if err := tmClient.VCDClient.Client.PostEntity(projectsURL, nil, &project, &projectOut, nil); err != nil {
+		return projectOut, fmt.Errorf("error creating project %s: %s", project.Name, err)
+	}
+
+	return projectOut, nil
+}
+
+// UpdateProject replaces the Project with the given one. The Project must carry the
+// resource version it was read with.
+func UpdateProject(tmClient *vcfa.VCDClient, project ccitypes.Project) (ccitypes.Project, error) {
+	var projectOut ccitypes.Project
+
+	projectURL, err := tmClient.VCDClient.Client.GetEntityUrl(fmt.Sprintf("%s/%s", ccitypes.ProjectsURL, project.Name))
+	if err != nil {
+		return projectOut, fmt.Errorf("error getting project URL: %s", err)
+	}
+
+	if err := tmClient.VCDClient.Client.PutEntity(projectURL, nil, &project, &projectOut, nil); err != nil {
+		return projectOut, fmt.Errorf("error updating project %s: %s", project.Name, err)
+	}
+
+	return projectOut, nil
+}
+
+// DeleteProject deletes the Project with the given name
+func DeleteProject(tmClient *vcfa.VCDClient, projectName string) error {
+	projectURL, err := tmClient.VCDClient.Client.GetEntityUrl(fmt.Sprintf("%s/%s", ccitypes.ProjectsURL, projectName))
+	if err != nil {
+		return fmt.Errorf("error getting project URL: %s", err)
+	}
+
+	if err := tmClient.VCDClient.Client.DeleteEntity(projectURL, nil, nil); err != nil {
+		return fmt.Errorf("error deleting project %s: %w", projectName, err)
+	}
+
+	return nil
+}
+
+// GetProjectRoleBinding retrieves the ProjectRoleBinding with the given name from the given Project
+func GetProjectRoleBinding(tmClient *vcfa.VCDClient, projectName string, name string) (vcfatypes.ProjectRoleBinding, error) {
+	var binding vcfatypes.ProjectRoleBinding
+
+	bindingURL, err := buildProjectRoleBindingURL(tmClient, projectName, name)
+	if err != nil {
+		return binding, err
+	}
+
+	if err := tmClient.VCDClient.Client.GetEntity(bindingURL, nil, &binding, nil); err != nil {
+		if govcd.ContainsNotFound(err) {
+			return binding, fmt.Errorf("project role binding %s not found in project %s: %w", name, projectName, err)
+		}
+		return binding, fmt.Errorf("error getting project role binding %s in project %s: %s", name, projectName, err)
+	}
+
+	return binding, nil
+}
+
+// CreateProjectRoleBinding creates the given ProjectRoleBinding in the given Project
+func CreateProjectRoleBinding(tmClient *vcfa.VCDClient, projectName string, binding vcfatypes.ProjectRoleBinding) (vcfatypes.ProjectRoleBinding, error) {
+	var bindingOut vcfatypes.ProjectRoleBinding
+
+	bindingsURL, err := buildProjectRoleBindingURL(tmClient, projectName, "")
+	if err != nil {
+		return bindingOut, err
+	}
+
+	if err := tmClient.VCDClient.Client.PostEntity(bindingsURL, nil, &binding, &bindingOut, nil); err != nil {
+		return bindingOut, fmt.Errorf("error creating project role binding %s in project %s: %s", binding.Name, projectName, err)
+	}
+
+	return bindingOut, nil
+}
+
+// DeleteProjectRoleBinding deletes the ProjectRoleBinding with the given name from the given Project
+func DeleteProjectRoleBinding(tmClient *vcfa.VCDClient, projectName string, name string) error {
+	bindingURL, err := buildProjectRoleBindingURL(tmClient, projectName, name)
+	if err != nil {
+		return err
+	}
+
+	if err := tmClient.VCDClient.Client.DeleteEntity(bindingURL, nil, nil); err != nil {
+		return fmt.Errorf("error deleting project role binding %s in project %s: %w", name, projectName, err)
+	}
+
+	return nil
+}
+
+func buildProjectRoleBindingURL(tmClient *vcfa.VCDClient, projectName string, name string) (*url.URL, error) {
+	bindingRawURL := fmt.Sprintf(vcfatypes.ProjectRoleBindingsURL, projectName)
+	if name != "" {
+		bindingRawURL = bindingRawURL + "/" + url.PathEscape(name)
+	}
+
+	bindingURL, err := tmClient.VCDClient.Client.GetEntityUrl(bindingRawURL)
+	if err != nil {
+		return nil, fmt.Errorf("error getting project role binding URL: %s", err)
+	}
+	return bindingURL, nil
+}
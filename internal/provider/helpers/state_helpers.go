@@ -41,6 +41,15 @@ func SetFrom(ctx context.Context, elemType attr.Type, val any, diags *diag.Diagn
 	return set
 }
 
+// StringOrNull returns a null types.String for an empty string, so that values the API
+// omits are stored as null rather than as an empty string.
+func StringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
 // SanitizeUnknownForState walks every Terraform Plugin Framework value embedded in the model
 // struct tree and replaces any "unknown" value with its null equivalent. Terraform rejects
 // unknown state values after apply, so this must be called before resp.State.Set when the
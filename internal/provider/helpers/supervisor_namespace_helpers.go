@@ -20,17 +20,9 @@ func GetSupervisorNamespaceEndpointURL(tmClient *vcfa.VCDClient, projectName str
 		return "", fmt.Errorf("error getting project %s: %s", projectName, err)
 	}
 
-	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, supervisorNamespaceName)
+	supervisorNamespace, err := GetSupervisorNamespace(tmClient, projectName, supervisorNamespaceName)
 	if err != nil {
-		return "", fmt.Errorf("error getting supervisor namespace endpoint URL: %s", err)
-	}
-
-	var supervisorNamespace ccitypes.SupervisorNamespace
-	if err := tmClient.VCDClient.Client.GetEntity(supervisorNamespaceURL, nil, &supervisorNamespace, nil); err != nil {
-		if govcd.ContainsNotFound(err) {
-			return "", fmt.Errorf("supervisor namespace %s not found in project %s", supervisorNamespaceName, projectName)
-		}
-		return "", fmt.Errorf("error getting supervisor namespace %s in project %s: %s", supervisorNamespaceName, projectName, err)
+		return "", err
 	}
 
 	readyStatus := false
@@ -52,6 +44,25 @@ func GetSupervisorNamespaceEndpointURL(tmClient *vcfa.VCDClient, projectName str
 	return supervisorNamespace.Status.NamespaceEndpointURL, nil
 }
 
+// GetSupervisorNamespace retrieves the Supervisor Namespace with the given name from the given project
+func GetSupervisorNamespace(tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (ccitypes.SupervisorNamespace, error) {
+	var supervisorNamespace ccitypes.SupervisorNamespace
+
+	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		return supervisorNamespace, fmt.Errorf("error getting supervisor namespace URL: %s", err)
+	}
+
+	if err := tmClient.VCDClient.Client.GetEntity(supervisorNamespaceURL, nil, &supervisorNamespace, nil); err != nil {
+		if govcd.ContainsNotFound(err) {
+			return supervisorNamespace, fmt.Errorf("supervisor namespace %s not found in project %s", supervisorNamespaceName, projectName)
+		}
+		return supervisorNamespace, fmt.Errorf("error getting supervisor namespace %s in project %s: %s", supervisorNamespaceName, projectName, err)
+	}
+
+	return supervisorNamespace, nil
+}
+
 func buildSupervisorNamespaceURL(tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (*url.URL, error) {
 	supervisorNamespaceRawURL := fmt.Sprintf(ccitypes.SupervisorNamespacesURL, projectName)
 	if supervisorNamespaceName != "" {
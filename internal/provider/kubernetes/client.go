@@ -11,10 +11,12 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/vmware/go-vcloud-director/v3/util"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,6 +48,20 @@ func NewClient(tmClient *vcfa.VCDClient, projectName string, supervisorNamespace
 		return nil, fmt.Errorf("error creating Kubernetes rest config: %w", err)
 	}
 
+	return newClientForConfig(restConfig)
+}
+
+// NewClientFromKubeconfig creates a Client for an arbitrary Kubernetes API server (e.g. a VKS
+// workload cluster) described by the given raw kubeconfig.
+func NewClientFromKubeconfig(kubeconfig []byte) (*Client, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes rest config from kubeconfig: %w", err)
+	}
+	return newClientForConfig(restConfig)
+}
+
+func newClientForConfig(restConfig *rest.Config) (*Client, error) {
 	restConfig.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
 		return &kubernetesloggingRoundTripper{wrapped: rt}
 	}
@@ -140,6 +156,26 @@ func (k *Client) ReadNamespaceScopedResource(ctx context.Context, namespace, nam
 	return nil
 }
 
+func (k *Client) ListNamespaceScopedResources(ctx context.Context, namespace string, gvr schema.GroupVersionResource, labelSelector string, outType any) error {
+	util.Logger.Printf("[K8S] Listing resources %s in namespace %s (label selector: %q) into target type %s", gvr.String(), namespace, labelSelector, reflect.TypeOf(outType))
+
+	result, err := k.dynamicClient.Resource(gvr).Namespace(namespace).List(
+		ctx,
+		metav1.ListOptions{
+			LabelSelector: labelSelector,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error listing resources %s in namespace %s: %w", gvr.String(), namespace, err)
+	}
+
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(result.UnstructuredContent(), outType); err != nil {
+		return fmt.Errorf("error converting %s list result to resource object %s: %w", gvr.String(), reflect.TypeOf(outType), err)
+	}
+
+	return nil
+}
+
 func (k *Client) UpdateNamespaceScopedResource(ctx context.Context, gvr schema.GroupVersionResource, namespace string, payload any, outType any, dryRun bool) error {
 	util.Logger.Printf("[K8S] Updating resource %s in namespace %s (target type: %s)", gvr.String(), namespace, reflect.TypeOf(outType))
 
@@ -208,6 +244,50 @@ func (k *Client) PatchNamespaceScopedResource(ctx context.Context, gvr schema.Gr
 	return nil
 }
 
+// MergePatchNamespaceScopedResource applies a JSON merge patch (RFC 7396) to a namespace scoped resource
+// using optimistic concurrency: the live resourceVersion is injected into the patch and the request is
+// re-sent up to maxRetries times when the API reports a conflict.
+func (k *Client) MergePatchNamespaceScopedResource(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, patchData []byte, outType any, maxRetries int, retryInterval time.Duration) error {
+	var patchMap map[string]any
+	if err := json.Unmarshal(patchData, &patchMap); err != nil {
+		return fmt.Errorf("could not unmarshal patch: %w", err)
+	}
+
+	var patchErr error
+	for attempt := 1; attempt <= maxRetries; attempt++ {
+		var current metav1.PartialObjectMetadata
+		if err := k.ReadNamespaceScopedResource(ctx, namespace, name, gvr, &current); err != nil {
+			return err
+		}
+
+		meta, _ := patchMap["metadata"].(map[string]any)
+		if meta == nil {
+			meta = make(map[string]any)
+		}
+		meta["resourceVersion"] = current.ResourceVersion
+		patchMap["metadata"] = meta
+
+		finalPatch, err := json.Marshal(patchMap)
+		if err != nil {
+			return fmt.Errorf("could not marshal final patch: %w", err)
+		}
+
+		patchErr = k.PatchNamespaceScopedResource(ctx, gvr, namespace, name, types.MergePatchType, finalPatch, outType, false)
+		if patchErr == nil || !apierrors.IsConflict(patchErr) {
+			return patchErr
+		}
+
+		util.Logger.Printf("[K8S] Conflict patching resource %s %s/%s (attempt %d/%d), retrying in %s", gvr.String(), namespace, name, attempt, maxRetries, retryInterval)
+		select {
+		case <-time.After(retryInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("context cancelled while retrying conflict patch: %w", ctx.Err())
+		}
+	}
+
+	return patchErr
+}
+
 func (k *Client) DeleteNamespaceScopedResource(ctx context.Context, namespace, name string, gvr schema.GroupVersionResource, dryRun bool) error {
 	util.Logger.Printf("[K8S] Deleting resource %s %s/%s", gvr.String(), namespace, name)
 
@@ -247,6 +327,73 @@ func (k *Client) ReadSecret(ctx context.Context, namespace string, name string)
 	return secret, nil
 }
 
+// CreateSecret creates the given Secret in its namespace
+func (k *Client) CreateSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	util.Logger.Printf("[K8S] Creating secret %s/%s", secret.Namespace, secret.Name)
+
+	created, err := k.mainClientSet.CoreV1().Secrets(secret.Namespace).Create(
+		ctx,
+		secret,
+		metav1.CreateOptions{FieldManager: defaultFieldManager},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	return created, nil
+}
+
+// UpdateSecret replaces the given Secret. The resource version of secret must be set to
+// the one of the latest read, to detect conflicting changes.
+func (k *Client) UpdateSecret(ctx context.Context, secret *corev1.Secret) (*corev1.Secret, error) {
+	util.Logger.Printf("[K8S] Updating secret %s/%s", secret.Namespace, secret.Name)
+
+	updated, err := k.mainClientSet.CoreV1().Secrets(secret.Namespace).Update(
+		ctx,
+		secret,
+		metav1.UpdateOptions{FieldManager: defaultFieldManager},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error updating secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	return updated, nil
+}
+
+// DeleteSecret deletes the Secret with the given name
+func (k *Client) DeleteSecret(ctx context.Context, namespace string, name string) error {
+	util.Logger.Printf("[K8S] Deleting secret %s/%s", namespace, name)
+
+	if err := k.mainClientSet.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("error deleting secret %s/%s: %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+func (k *Client) ListPersistentVolumes(ctx context.Context) (*corev1.PersistentVolumeList, error) {
+	util.Logger.Printf("[K8S] Listing persistent volumes")
+
+	volumes, err := k.mainClientSet.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing persistent volumes: %w", err)
+	}
+
+	return volumes, nil
+}
+
+// ListServices lists the Services of the given namespace, or of all namespaces when namespace is empty.
+func (k *Client) ListServices(ctx context.Context, namespace string) (*corev1.ServiceList, error) {
+	util.Logger.Printf("[K8S] Listing services in namespace %q", namespace)
+
+	services, err := k.mainClientSet.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing services in namespace %q: %w", namespace, err)
+	}
+
+	return services, nil
+}
+
 func getKubernetesRestConfig(tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (*rest.Config, error) {
 	// Get Supervisor Namespace URL
 	clusterName := fmt.Sprintf("%s:%s@%s", tmClient.Org, supervisorNamespaceName, tmClient.Client.VCDHREF.Host)
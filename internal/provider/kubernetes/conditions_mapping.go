@@ -26,3 +26,20 @@ func MapConditionsToModel(ctx context.Context, conditions []metav1.Condition, di
 	}
 	return result
 }
+
+// MapConditionToModel returns the model of the condition with the given type,
+// or nil when the condition is not present in the list.
+func MapConditionToModel(conditions []metav1.Condition, conditionType string) *ConditionModel {
+	c := FindCondition(conditions, conditionType)
+	if c == nil {
+		return nil
+	}
+	return &ConditionModel{
+		Type:               types.StringValue(c.Type),
+		Status:             types.StringValue(string(c.Status)),
+		ObservedGeneration: types.Int64Value(c.ObservedGeneration),
+		LastTransitionTime: types.StringValue(c.LastTransitionTime.Format("2006-01-02T15:04:05Z")),
+		Reason:             types.StringValue(c.Reason),
+		Message:            types.StringValue(c.Message),
+	}
+}
@@ -73,3 +73,36 @@ var ConditionsResourceSchema = schema.SetNestedAttribute{
 		},
 	},
 }
+
+// ConditionDataSourceSchema describes a single condition picked out of an
+// object's condition list, such as the `Available` condition of a cluster.
+var ConditionDataSourceSchema = schema.SingleNestedAttribute{
+	Computed:    true,
+	Description: "Condition of the resource; null when the condition has not been reported yet",
+	Attributes: map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Type of condition",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the condition (True, False, Unknown)",
+		},
+		"observed_generation": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Generation that was current when this condition was last updated",
+		},
+		"last_transition_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Last time the condition transitioned",
+		},
+		"reason": schema.StringAttribute{
+			Computed:    true,
+			Description: "Machine-readable reason for the condition",
+		},
+		"message": schema.StringAttribute{
+			Computed:    true,
+			Description: "Human-readable message for the condition",
+		},
+	},
+}
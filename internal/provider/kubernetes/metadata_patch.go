@@ -0,0 +1,126 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FilterToUserManagedKeys returns a types.Map whose keys are restricted to those
+// that were present in priorState.  For each such key the value from the live API
+// map (apiMap) is used so that actual server-side values are reflected.  Keys that
+// the API no longer has are silently dropped (Terraform will show an add-diff on
+// the next plan if they are still present in config).  When priorState is null/unknown
+// or all keys are absent from the API the result is types.MapNull.
+func FilterToUserManagedKeys(ctx context.Context, apiMap map[string]string, priorState types.Map, diags *diag.Diagnostics) types.Map {
+	if priorState.IsNull() || priorState.IsUnknown() || len(priorState.Elements()) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	var priorKeys map[string]string
+	diags.Append(priorState.ElementsAs(ctx, &priorKeys, false)...)
+	if diags.HasError() {
+		return types.MapNull(types.StringType)
+	}
+	result := make(map[string]attr.Value, len(priorKeys))
+	for k := range priorKeys {
+		if v, ok := apiMap[k]; ok {
+			result[k] = types.StringValue(v)
+		}
+	}
+	if len(result) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	filtered, d := types.MapValue(types.StringType, result)
+	diags.Append(d...)
+	return filtered
+}
+
+// InjectPerKeyMapDiffs post-processes a JSON merge-patch so that
+// metadata.labels and metadata.annotations are expressed as per-key diffs
+// rather than a single null (which would wipe all backend-managed keys).
+func InjectPerKeyMapDiffs(ctx context.Context, patchBytes []byte, stateLabels, planLabels, stateAnnotations, planAnnotations types.Map, diags *diag.Diagnostics) ([]byte, error) {
+	labelDiff := computePerKeyMapDiff(ctx, stateLabels, planLabels, diags)
+	annotationDiff := computePerKeyMapDiff(ctx, stateAnnotations, planAnnotations, diags)
+
+	if len(labelDiff) == 0 && len(annotationDiff) == 0 {
+		return patchBytes, nil
+	}
+
+	var patchMap map[string]any
+	if err := json.Unmarshal(patchBytes, &patchMap); err != nil {
+		return patchBytes, err
+	}
+
+	// Ensure there is a "metadata" map in the patch.
+	var metadata map[string]any
+	if existing, ok := patchMap["metadata"]; ok {
+		if m, ok := existing.(map[string]any); ok {
+			metadata = m
+		} else {
+			metadata = map[string]any{}
+		}
+	} else {
+		metadata = map[string]any{}
+	}
+
+	if len(labelDiff) > 0 {
+		metadata["labels"] = labelDiff
+	} else {
+		// No user-managed label changes: ensure we don't accidentally send a
+		// coarse {"labels": null} from the raw merge-patch.
+		delete(metadata, "labels")
+	}
+
+	if len(annotationDiff) > 0 {
+		metadata["annotations"] = annotationDiff
+	} else {
+		delete(metadata, "annotations")
+	}
+
+	if len(metadata) > 0 {
+		patchMap["metadata"] = metadata
+	} else {
+		delete(patchMap, "metadata")
+	}
+
+	return json.Marshal(patchMap)
+}
+
+// computePerKeyMapDiff returns a map[string]any suitable for embedding in a JSON
+// merge-patch (RFC 7396).  Keys that exist in oldMap but are absent from newMap
+// are set to nil (which serialises as JSON null, signalling deletion to the API).
+// Keys that are new or have changed values in newMap are set to their new string
+// value.  Unchanged keys are omitted so the patch is minimal.
+// This avoids sending {"labels": null} which would erase ALL labels – including
+// backend-injected ones – when the user simply removes their last label.
+func computePerKeyMapDiff(ctx context.Context, oldMap, newMap types.Map, diags *diag.Diagnostics) map[string]any {
+	var oldKeys, newKeys map[string]string
+	if !oldMap.IsNull() && !oldMap.IsUnknown() {
+		diags.Append(oldMap.ElementsAs(ctx, &oldKeys, false)...)
+	}
+	if !newMap.IsNull() && !newMap.IsUnknown() {
+		diags.Append(newMap.ElementsAs(ctx, &newKeys, false)...)
+	}
+	if len(oldKeys) == 0 && len(newKeys) == 0 {
+		return nil
+	}
+	result := make(map[string]any)
+	for k := range oldKeys {
+		if _, ok := newKeys[k]; !ok {
+			result[k] = nil // JSON null → remove from API
+		}
+	}
+	for k, v := range newKeys {
+		if oldV, ok := oldKeys[k]; !ok || oldV != v {
+			result[k] = v
+		}
+	}
+	return result
+}
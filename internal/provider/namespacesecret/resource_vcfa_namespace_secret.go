@@ -0,0 +1,328 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespacesecret
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ resource.Resource                   = (*vcfaNamespaceSecretResource)(nil)
+	_ resource.ResourceWithConfigure      = (*vcfaNamespaceSecretResource)(nil)
+	_ resource.ResourceWithImportState    = (*vcfaNamespaceSecretResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*vcfaNamespaceSecretResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*vcfaNamespaceSecretResource)(nil)
+)
+
+type vcfaNamespaceSecretResource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaNamespaceSecretResource() resource.Resource {
+	return &vcfaNamespaceSecretResource{}
+}
+
+func (r *vcfaNamespaceSecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace_secret"
+}
+
+func (r *vcfaNamespaceSecretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving TM client from provider data", err.Error())
+		return
+	}
+	r.tmClient = tmClient
+}
+
+func (r *vcfaNamespaceSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config vcfaNamespaceSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	// The data is write-only, so it is only available in the configuration
+	data := extractSecretData(ctx, config.DataWO, &resp.Diagnostics)
+	secret := mapResourceModelToSecret(ctx, &plan, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := k8sClient.CreateSecret(ctx, secret)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("could not create %s %s in VCF context %s/%s: %s", vcfatypes.LabelNamespaceSecret, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	mapSecretToResourceModel(ctx, created, &plan, &resp.Diagnostics)
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaNamespaceSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vcfaNamespaceSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	secret, err := k8sClient.ReadSecret(ctx, namespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelNamespaceSecret, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	// The hash of the data read from the API replaces the one in the state, so that
+	// changes made outside of Terraform show up as a diff with the hash of data_wo.
+	mapSecretToResourceModel(ctx, secret, &state, &resp.Diagnostics)
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	// Keep only the user-managed subset of labels/annotations so that
+	// backend-injected entries never appear as diffs in the plan.
+	state.Labels = kubernetes.FilterToUserManagedKeys(ctx, secret.Labels, state.Labels, &resp.Diagnostics)
+	state.Annotations = kubernetes.FilterToUserManagedKeys(ctx, secret.Annotations, state.Annotations, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vcfaNamespaceSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan, config vcfaNamespaceSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	current, err := k8sClient.ReadSecret(ctx, namespace, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelNamespaceSecret, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	// The data is replaced as a whole, while only the user-managed labels and
+	// annotations are changed, so that the backend-injected ones are preserved.
+	current.Data = extractSecretData(ctx, config.DataWO, &resp.Diagnostics)
+	current.StringData = nil
+	current.Labels = applyPerKeyChanges(ctx, current.Labels, state.Labels, plan.Labels, &resp.Diagnostics)
+	current.Annotations = applyPerKeyChanges(ctx, current.Annotations, state.Annotations, plan.Annotations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := k8sClient.UpdateSecret(ctx, current)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("could not update %s %s in VCF context %s/%s: %s", vcfatypes.LabelNamespaceSecret, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	mapSecretToResourceModel(ctx, updated, &plan, &resp.Diagnostics)
+
+	// The metadata changes with every update (e.g. resource_version); keep the planned
+	// value to prevent an inconsistent result. The next Read refreshes it.
+	plan.Metadata = state.Metadata
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaNamespaceSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vcfaNamespaceSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	if err := k8sClient.DeleteSecret(ctx, namespace, name); err != nil && !apierrors.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelNamespaceSecret, name),
+			fmt.Sprintf("could not delete %s %s in VCF context %s/%s: %s", vcfatypes.LabelNamespaceSecret, name, project, namespace, err.Error()),
+		)
+	}
+}
+
+func (r *vcfaNamespaceSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, vcfa.ImportSeparator, 4)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"invalid import ID format",
+			fmt.Sprintf("expected project%snamespace%sname, got: %s", vcfa.ImportSeparator, vcfa.ImportSeparator, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("namespace"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+// ModifyPlan plans the hash and the keys of the write-only data, which are only available in
+// the configuration. A hash that differs from the one in the state, either because data_wo
+// changed or because the secret was changed outside of Terraform, plans an update.
+// On create, the salt is not known until apply, so the hash is left unknown.
+func (r *vcfaNamespaceSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config vcfaNamespaceSecretResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.DataWO.IsUnknown() || config.DataWO.IsNull() {
+		return
+	}
+
+	data := extractSecretData(ctx, config.DataWO, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("data_keys"), dataKeysToSet(ctx, data, &resp.Diagnostics))...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var salt types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("data_hash_salt"), &salt)...)
+	if resp.Diagnostics.HasError() || salt.IsNull() || salt.ValueString() == "" {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("data_hash"), types.StringValue(hashSecretData(salt.ValueString(), data)))...)
+}
+
+func (r *vcfaNamespaceSecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config vcfaNamespaceSecretResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.DataWO.IsUnknown() || config.DataWO.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+
+	secretType := config.Type.ValueString()
+	if config.Type.IsNull() {
+		secretType = vcfatypes.SecretTypeOpaque
+	}
+	data := config.DataWO.Elements()
+	for _, key := range vcfatypes.SecretTypeRequiredKeys[secretType] {
+		if _, ok := data[key]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("data_wo"),
+				"Missing secret data key",
+				fmt.Sprintf("secrets of type %s require the key %q in data_wo", secretType, key),
+			)
+		}
+	}
+}
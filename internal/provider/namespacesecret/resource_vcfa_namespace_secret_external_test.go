@@ -0,0 +1,130 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespacesecret_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+// TestAccVcfaNamespaceSecretResourceExternal exercises the full lifecycle
+// (create → update → import → destroy) of the vcfa_namespace_secret resource
+// against a live environment.
+func TestAccVcfaNamespaceSecretResourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	// Kubernetes resource names must be lowercase DNS labels.
+	name := strings.ReplaceAll(strings.ToLower(t.Name()), "_", "-")
+
+	params := testutils.StringMap{
+		"Project":   cfg.VmService.Project,
+		"Namespace": cfg.VmService.Namespace,
+		"Name":      name,
+		"Password":  "first-password",
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText1 := testutils.TemplateFill(t, testAccVcfaNamespaceSecretExternalConfig, params)
+	params["FuncName"] = t.Name() + "-update"
+	params["Password"] = "second-password"
+	configText2 := testutils.TemplateFill(t, testAccVcfaNamespaceSecretExternalConfig, params)
+
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	var firstHash string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		// Write-only attributes require Terraform 1.11 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Step 1: create the secret.
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vcfa_namespace_secret.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_namespace_secret.test", "name", name),
+					resource.TestCheckResourceAttr("vcfa_namespace_secret.test", "type", "Opaque"),
+					resource.TestCheckNoResourceAttr("vcfa_namespace_secret.test", "data_wo"),
+					resource.TestCheckResourceAttr("vcfa_namespace_secret.test", "data_keys.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vcfa_namespace_secret.test", "data_keys.*", "username"),
+					resource.TestCheckTypeSetElemAttr("vcfa_namespace_secret.test", "data_keys.*", "password"),
+					resource.TestCheckResourceAttrSet("vcfa_namespace_secret.test", "metadata.uid"),
+					resource.TestCheckResourceAttrSet("vcfa_namespace_secret.test", "data_hash_salt"),
+					func(s *terraform.State) error {
+						firstHash = s.RootModule().Resources["vcfa_namespace_secret.test"].Primary.Attributes["data_hash"]
+						return nil
+					},
+				),
+			},
+			// Step 2: change the write-only data, which changes the hash.
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("vcfa_namespace_secret.test", "data_wo"),
+					resource.TestCheckResourceAttrWith("vcfa_namespace_secret.test", "data_hash", func(value string) error {
+						if value == "" || value == firstHash {
+							return fmt.Errorf("expected data_hash to change from %q, got %q", firstHash, value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Step 3: import and verify the state round-trips cleanly.
+			{
+				ResourceName:      "vcfa_namespace_secret.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["Project"].(string) + vcfa.ImportSeparator + params["Namespace"].(string) + vcfa.ImportSeparator + params["Name"].(string), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					"data_wo",        // write-only
+					"data_hash",      // keyed with a new salt on import
+					"data_hash_salt", // random, generated on import
+					"labels",         // user-managed subset, unknown on import
+					"annotations",    // user-managed subset, unknown on import
+					"metadata",       // computed-only
+				},
+			},
+		},
+	})
+}
+
+// testAccVcfaNamespaceSecretExternalConfig is the HCL template of every step.
+const testAccVcfaNamespaceSecretExternalConfig = `
+resource "vcfa_namespace_secret" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  name = "{{.Name}}"
+
+  labels = {
+    app = "{{.Name}}"
+  }
+
+  data_wo = {
+    username = "admin"
+    password = "{{.Password}}"
+  }
+}
+`
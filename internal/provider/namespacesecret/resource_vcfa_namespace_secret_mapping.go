@@ -0,0 +1,135 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespacesecret
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// ── API → Terraform state ────────────────────────────────────────────────────
+
+func mapSecretToResourceModel(ctx context.Context, secret *vcfatypes.Secret, model *vcfaNamespaceSecretResourceModel, diags *diag.Diagnostics) {
+	model.Metadata = helpers.ObjFrom(ctx, kubernetes.MetadataAttrTypes,
+		kubernetes.MapMetadataToModel(ctx, secret.ObjectMeta, diags), diags)
+
+	secretType := string(secret.Type)
+	if secretType == "" {
+		secretType = vcfatypes.SecretTypeOpaque
+	}
+	model.Type = types.StringValue(secretType)
+	// Secrets created before the salt was introduced, or imported ones, get a new salt
+	if model.DataHashSalt.IsNull() || model.DataHashSalt.IsUnknown() || model.DataHashSalt.ValueString() == "" {
+		model.DataHashSalt = types.StringValue(newSecretDataHashSalt())
+	}
+	model.DataHash = types.StringValue(hashSecretData(model.DataHashSalt.ValueString(), secret.Data))
+	model.DataKeys = dataKeysToSet(ctx, secret.Data, diags)
+
+	// Write-only attributes are never stored
+	model.DataWO = types.MapNull(types.StringType)
+}
+
+// newSecretDataHashSalt returns a random salt for the hash of the secret data
+func newSecretDataHashSalt() string {
+	return rand.Text()
+}
+
+// hashSecretData returns the HMAC-SHA256 of the given secret data, keyed with the given salt.
+// The salt is random for every resource, so that the values of the secret cannot be found by
+// comparing the hash with precomputed ones. Keys are hashed in a stable order, so that the same
+// data always produces the same hash.
+func hashSecretData(salt string, data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := hmac.New(sha256.New, []byte(salt))
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(data[k])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func dataKeysToSet(ctx context.Context, data map[string][]byte, diags *diag.Diagnostics) types.Set {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, keys)
+	diags.Append(d...)
+	return set
+}
+
+// ── Terraform plan → API ─────────────────────────────────────────────────────
+
+// extractSecretData returns the data of the write-only data_wo map, as stored by the API
+func extractSecretData(ctx context.Context, dataWO types.Map, diags *diag.Diagnostics) map[string][]byte {
+	values := helpers.ExtractStringMap(ctx, dataWO, diags)
+	if values == nil {
+		return nil
+	}
+	data := make(map[string][]byte, len(values))
+	for k, v := range values {
+		data[k] = []byte(v)
+	}
+	return data
+}
+
+func mapResourceModelToSecret(ctx context.Context, model *vcfaNamespaceSecretResourceModel, data map[string][]byte, diags *diag.Diagnostics) *vcfatypes.Secret {
+	vcfContext := common.ExtractVcfContext(ctx, model.Context, diags)
+
+	return &vcfatypes.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vcfatypes.CoreVersion,
+			Kind:       vcfatypes.SecretKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        model.Name.ValueString(),
+			Namespace:   vcfContext.Namespace.ValueString(),
+			Labels:      helpers.ExtractStringMap(ctx, model.Labels, diags),
+			Annotations: helpers.ExtractStringMap(ctx, model.Annotations, diags),
+		},
+		Type: corev1.SecretType(model.Type.ValueString()),
+		Data: data,
+	}
+}
+
+// applyPerKeyChanges returns current with the keys of prior removed and the keys of planned
+// set, so that the entries that are not managed by Terraform are preserved.
+func applyPerKeyChanges(ctx context.Context, current map[string]string, prior types.Map, planned types.Map, diags *diag.Diagnostics) map[string]string {
+	result := make(map[string]string, len(current))
+	for k, v := range current {
+		result[k] = v
+	}
+	for k := range helpers.ExtractStringMap(ctx, prior, diags) {
+		delete(result, k)
+	}
+	for k, v := range helpers.ExtractStringMap(ctx, planned, diags) {
+		result[k] = v
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespacesecret
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ── Resource Top-level model ─────────────────────────────────────────────────
+
+type vcfaNamespaceSecretResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Context types.Object `tfsdk:"context"`
+	Name    types.String `tfsdk:"name"`
+
+	// Metadata
+	Metadata types.Object `tfsdk:"metadata"`
+
+	// User-managed labels and annotations on the secret's ObjectMeta.
+	// Only the keys the user specifies are tracked; backend-injected entries are ignored.
+	Labels      types.Map `tfsdk:"labels"`
+	Annotations types.Map `tfsdk:"annotations"`
+
+	Type types.String `tfsdk:"type"`
+
+	// DataWO is write-only: it is only available in the configuration and never stored.
+	// DataHash tracks it in the state instead, keyed with the random DataHashSalt.
+	DataWO       types.Map    `tfsdk:"data_wo"`
+	DataHash     types.String `tfsdk:"data_hash"`
+	DataHashSalt types.String `tfsdk:"data_hash_salt"`
+	DataKeys     types.Set    `tfsdk:"data_keys"`
+}
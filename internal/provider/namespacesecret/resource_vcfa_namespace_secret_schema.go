@@ -0,0 +1,115 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespacesecret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (r *vcfaNamespaceSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Resource for managing a %s. The secret data is write-only and never stored in the Terraform state.", vcfatypes.LabelNamespaceSecret),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Internal identifier of the %s", vcfatypes.LabelNamespaceSecret),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Required attributes
+			"context": common.VcfContextResourceSchema,
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s (must be RFC 1123 DNS subdomain compliant)", vcfatypes.LabelNamespaceSecret),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kubernetes.ReDNSSubdomain, "must be a valid DNS subdomain"),
+				},
+			},
+
+			// Metadata attributes
+			"metadata": kubernetes.MetadataResourceSchema,
+
+			// User-managed secret metadata. Only the keys explicitly set here are tracked in
+			// Terraform state; any additional labels/annotations injected by the backend are
+			// silently ignored and will never appear in the plan diff.
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed labels to set on the secret's ObjectMeta. Keys not present here are not tracked, so backend-injected labels are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed annotations to set on the secret's ObjectMeta. Keys not present here are not tracked, so backend-injected annotations are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(vcfatypes.SecretTypeOpaque),
+				Description: fmt.Sprintf("Type of the secret: '%s' (default), '%s' or '%s'", vcfatypes.SecretTypeOpaque, vcfatypes.SecretTypeDockerConfigJson, vcfatypes.SecretTypeTLS),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(vcfatypes.SecretTypeOpaque, vcfatypes.SecretTypeDockerConfigJson, vcfatypes.SecretTypeTLS),
+				},
+			},
+			"data_wo": schema.MapAttribute{
+				Required:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Write-only data of the secret. Values are plain text and are never stored in the Terraform state. Requires Terraform 1.11 or later",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"data_hash": schema.StringAttribute{
+				Computed:    true,
+				Description: "HMAC-SHA256 of the secret data, keyed with `data_hash_salt`, used to detect changes of `data_wo` and of the secret outside of Terraform",
+			},
+			"data_hash_salt": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Random salt of `data_hash`, generated when the resource is created or imported",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_keys": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Keys of the secret data",
+			},
+		},
+	}
+}
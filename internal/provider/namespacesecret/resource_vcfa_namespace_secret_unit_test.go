@@ -0,0 +1,128 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package namespacesecret
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func TestHashSecretData(t *testing.T) {
+	data := map[string][]byte{
+		"username": []byte("admin"),
+		"password": []byte("secret"),
+	}
+	hash := hashSecretData("salt", data)
+
+	// Maps are iterated in random order: hashing the same data many times must always give the same hash
+	for range 20 {
+		sameData := map[string][]byte{
+			"password": []byte("secret"),
+			"username": []byte("admin"),
+		}
+		if got := hashSecretData("salt", sameData); got != hash {
+			t.Fatalf("expected the same hash for the same data, got %s and %s", hash, got)
+		}
+	}
+
+	type testCase struct {
+		name string
+		salt string
+		data map[string][]byte
+	}
+	testCases := []testCase{
+		{name: "ChangedValue", salt: "salt", data: map[string][]byte{"username": []byte("admin"), "password": []byte("secret2")}},
+		{name: "ChangedKey", salt: "salt", data: map[string][]byte{"user": []byte("admin"), "password": []byte("secret")}},
+		{name: "AddedKey", salt: "salt", data: map[string][]byte{"username": []byte("admin"), "password": []byte("secret"), "token": nil}},
+		{name: "ValueMovedToKey", salt: "salt", data: map[string][]byte{"username": []byte("adminpassword"), "": []byte("secret")}},
+		{name: "ChangedSalt", salt: "pepper", data: data},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := hashSecretData(tc.salt, tc.data); got == hash {
+				t.Errorf("expected a different hash, got the same: %s", got)
+			}
+		})
+	}
+}
+
+// testSecretConfig returns the configuration of a vcfa_namespace_secret with the given type and data_wo. An empty
+// secret type leaves the type unset
+func testSecretConfig(t *testing.T, secretType string, data map[string]string) tfsdk.Config {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewVcfaNamespaceSecretResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("error getting the schema: %v", schemaResp.Diagnostics)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	if secretType != "" {
+		values["type"] = tftypes.NewValue(tftypes.String, secretType)
+	}
+	dataValues := make(map[string]tftypes.Value, len(data))
+	for k, v := range data {
+		dataValues[k] = tftypes.NewValue(tftypes.String, v)
+	}
+	values["data_wo"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, dataValues)
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	type testCase struct {
+		name          string
+		secretType    string
+		data          map[string]string
+		expectedError string
+	}
+
+	testCases := []testCase{
+		{name: "OpaqueByDefault", data: map[string]string{"password": "secret"}},
+		{name: "Opaque", secretType: vcfatypes.SecretTypeOpaque, data: map[string]string{}},
+		{name: "TLS", secretType: vcfatypes.SecretTypeTLS, data: map[string]string{"tls.crt": "cert", "tls.key": "key"}},
+		{name: "TLSWithoutCertificate", secretType: vcfatypes.SecretTypeTLS, data: map[string]string{"tls.key": "key"}, expectedError: `require the key "tls.crt"`},
+		{name: "TLSWithoutKey", secretType: vcfatypes.SecretTypeTLS, data: map[string]string{"tls.crt": "cert"}, expectedError: `require the key "tls.key"`},
+		{name: "DockerConfigJson", secretType: vcfatypes.SecretTypeDockerConfigJson, data: map[string]string{".dockerconfigjson": "{}"}},
+		{name: "DockerConfigJsonWithoutConfig", secretType: vcfatypes.SecretTypeDockerConfigJson, data: map[string]string{"config.json": "{}"}, expectedError: `require the key ".dockerconfigjson"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &vcfaNamespaceSecretResource{}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: testSecretConfig(t, tc.secretType, tc.data)}, resp)
+
+			if tc.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("expected no error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got %v", resp.Diagnostics)
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, tc.expectedError) {
+				t.Errorf("expected error containing %q, got %q", tc.expectedError, detail)
+			}
+		})
+	}
+}
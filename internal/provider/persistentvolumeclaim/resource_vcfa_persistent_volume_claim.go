@@ -0,0 +1,487 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package persistentvolumeclaim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+const (
+	persistentVolumeClaimCreateDefaultTimeout  = 10 * time.Minute
+	persistentVolumeClaimUpdateDefaultTimeout  = 10 * time.Minute
+	persistentVolumeClaimDeleteDefaultTimeout  = 5 * time.Minute
+	persistentVolumeClaimPollInterval          = 5 * time.Second
+	persistentVolumeClaimConflictMaxRetries    = 5
+	persistentVolumeClaimConflictRetryInterval = 2 * time.Second
+)
+
+var (
+	_ resource.Resource                = (*vcfaPersistentVolumeClaimResource)(nil)
+	_ resource.ResourceWithConfigure   = (*vcfaPersistentVolumeClaimResource)(nil)
+	_ resource.ResourceWithImportState = (*vcfaPersistentVolumeClaimResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*vcfaPersistentVolumeClaimResource)(nil)
+)
+
+type vcfaPersistentVolumeClaimResource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaPersistentVolumeClaimResource() resource.Resource {
+	return &vcfaPersistentVolumeClaimResource{}
+}
+
+func (r *vcfaPersistentVolumeClaimResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persistent_volume_claim"
+}
+
+func (r *vcfaPersistentVolumeClaimResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving TM client from provider data", err.Error())
+		return
+	}
+	r.tmClient = tmClient
+}
+
+func (r *vcfaPersistentVolumeClaimResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vcfaPersistentVolumeClaimResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, persistentVolumeClaimCreateDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitBound, diags := extractWaitForBound(ctx, plan.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	pvcObj := mapResourceModelToPersistentVolumeClaim(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created vcfatypes.PersistentVolumeClaim
+	if err := k8sClient.CreateNamespaceScopedResource(ctx, vcfatypes.GetPersistentVolumeClaimGVR(), namespace, pvcObj, &created, false); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not create %s %s in VCF context %s/%s: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	latest := &created
+	if waitBound {
+		ready, err := waitForPersistentVolumeClaimBound(ctx, k8sClient, project, namespace, name, createTimeout, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s created but not bound yet", vcfatypes.LabelPersistentVolumeClaim, name),
+				fmt.Sprintf("%s %s in VCF context %s/%s was created but did not reach the Bound phase: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+			)
+		} else {
+			latest = ready
+		}
+	}
+
+	mapPersistentVolumeClaimToResourceModel(ctx, latest, &plan, &resp.Diagnostics)
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaPersistentVolumeClaimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vcfaPersistentVolumeClaimResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	var pvc vcfatypes.PersistentVolumeClaim
+	if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetPersistentVolumeClaimGVR(), &pvc); err != nil {
+		if apierrors.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	mapPersistentVolumeClaimToResourceModel(ctx, &pvc, &state, &resp.Diagnostics)
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	// Keep only the user-managed subset of labels/annotations so that
+	// backend-injected entries never appear as diffs in the plan.
+	state.Labels = kubernetes.FilterToUserManagedKeys(ctx, pvc.Labels, state.Labels, &resp.Diagnostics)
+	state.Annotations = kubernetes.FilterToUserManagedKeys(ctx, pvc.Annotations, state.Annotations, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vcfaPersistentVolumeClaimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state vcfaPersistentVolumeClaimResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan vcfaPersistentVolumeClaimResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, persistentVolumeClaimUpdateDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitBound, diags := extractWaitForBound(ctx, plan.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	patchBytes, diags := createMergePatch(ctx, state, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var latest vcfatypes.PersistentVolumeClaim
+	// Only send the patch request if there are changes to apply.
+	if len(patchBytes) > 2 {
+		if err := k8sClient.MergePatchNamespaceScopedResource(ctx, vcfatypes.GetPersistentVolumeClaimGVR(), namespace, name, patchBytes, &latest, persistentVolumeClaimConflictMaxRetries, persistentVolumeClaimConflictRetryInterval); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("error updating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+				fmt.Sprintf("could not patch %s %s in VCF context %s/%s: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+			)
+			return
+		}
+	} else if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetPersistentVolumeClaimGVR(), &latest); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	if waitBound {
+		ready, err := waitForPersistentVolumeClaimBound(ctx, k8sClient, project, namespace, name, updateTimeout, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s updated but not bound yet", vcfatypes.LabelPersistentVolumeClaim, name),
+				fmt.Sprintf("%s %s in VCF context %s/%s was updated but did not reach the Bound phase: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+			)
+		} else {
+			latest = *ready
+		}
+	}
+
+	mapPersistentVolumeClaimToResourceModel(ctx, &latest, &plan, &resp.Diagnostics)
+
+	// The metadata changes with every update (e.g. resource_version); keep the planned
+	// value to prevent an inconsistent result. The next Read refreshes it.
+	plan.Metadata = state.Metadata
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaPersistentVolumeClaimResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vcfaPersistentVolumeClaimResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, persistentVolumeClaimDeleteDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitDeleted, diags := extractWaitForDeleted(ctx, state.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	if err := k8sClient.DeleteNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetPersistentVolumeClaimGVR(), false); err != nil {
+		if apierrors.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not delete %s %s in VCF context %s/%s: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	if waitDeleted {
+		if err := waitForPersistentVolumeClaimDeleted(ctx, k8sClient, project, namespace, name, deleteTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s deletion still in progress", vcfatypes.LabelPersistentVolumeClaim, name),
+				fmt.Sprintf("%s %s deletion in VCF context %s/%s was initiated but did not complete within the timeout: %s", vcfatypes.LabelPersistentVolumeClaim, name, project, namespace, err.Error()),
+			)
+		}
+	}
+}
+
+func (r *vcfaPersistentVolumeClaimResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, vcfa.ImportSeparator, 4)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"invalid import ID format",
+			fmt.Sprintf("expected project%snamespace%sname, got: %s", vcfa.ImportSeparator, vcfa.ImportSeparator, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("namespace"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+// ModifyPlan validates the storage class against the storage classes of the Supervisor
+// Namespace, and replaces the claim when its size is decreased, as volumes can only be
+// expanded in place.
+func (r *vcfaPersistentVolumeClaimResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vcfaPersistentVolumeClaimResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *vcfaPersistentVolumeClaimResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &vcfaPersistentVolumeClaimResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state != nil && !plan.Size.IsUnknown() && !state.Size.IsNull() {
+		planned, planErr := apiresource.ParseQuantity(plan.Size.ValueString())
+		current, stateErr := apiresource.ParseQuantity(state.Size.ValueString())
+		if planErr == nil && stateErr == nil && planned.Cmp(current) < 0 {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("size"))
+		}
+	}
+
+	if state == nil || !plan.StorageClass.Equal(state.StorageClass) {
+		r.validateStorageClass(ctx, plan, &resp.Diagnostics)
+	}
+}
+
+// validateStorageClass reports an error when the planned storage class is not one of the
+// storage classes of the Supervisor Namespace of the claim.
+func (r *vcfaPersistentVolumeClaimResource) validateStorageClass(ctx context.Context, plan vcfaPersistentVolumeClaimResourceModel, diags *diag.Diagnostics) {
+	// The provider is not configured yet during validation of unknown provider configuration
+	if r.tmClient == nil || plan.StorageClass.IsUnknown() || plan.Context.IsUnknown() {
+		return
+	}
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, diags)
+	if diags.HasError() || vcfContext.Project.IsUnknown() || vcfContext.Namespace.IsUnknown() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	storageClass := plan.StorageClass.ValueString()
+
+	supervisorNamespace, err := helpers.GetSupervisorNamespace(r.tmClient, project, namespace)
+	if err != nil {
+		// The namespace may be created in the same apply; let the API report the error then.
+		log.Printf("[DEBUG] skipping storage class validation of %s %s: %s", vcfatypes.LabelPersistentVolumeClaim, plan.Name.ValueString(), err)
+		return
+	}
+
+	available := make([]string, 0, len(supervisorNamespace.Status.StorageClasses))
+	for _, sc := range supervisorNamespace.Status.StorageClasses {
+		if sc.Name == storageClass {
+			return
+		}
+		available = append(available, sc.Name)
+	}
+	diags.AddAttributeError(
+		path.Root("storage_class"),
+		"Invalid storage class",
+		fmt.Sprintf("storage class %q is not available in Supervisor Namespace %s of project %s. Available storage classes: %s", storageClass, namespace, project, strings.Join(available, ", ")),
+	)
+}
+
+func createMergePatch(ctx context.Context, state vcfaPersistentVolumeClaimResourceModel, plan vcfaPersistentVolumeClaimResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := plan.Name.ValueString()
+
+	oldObj := mapResourceModelToPersistentVolumeClaim(ctx, &state, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+	oldJSON, err := json.Marshal(oldObj)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not marshal old state to JSON: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	newObj := mapResourceModelToPersistentVolumeClaim(ctx, &plan, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+	newJSON, err := json.Marshal(newObj)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not marshal new plan to JSON: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	// Compute the JSON Merge Patch (RFC 7396) from the diff between old and new, so
+	// that fields defaulted by the backend are never overwritten.
+	patchBytes, err := jsonpatch.CreateMergePatch(oldJSON, newJSON)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not compute merge patch: %s", err.Error()),
+		)
+		return patchBytes, diags
+	}
+
+	// Express label and annotation changes as per-key diffs so that removing the
+	// last user-managed key does not erase the backend-injected ones.
+	patchBytes, err = kubernetes.InjectPerKeyMapDiffs(ctx, patchBytes, state.Labels, plan.Labels, state.Annotations, plan.Annotations, &diags)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelPersistentVolumeClaim, name),
+			fmt.Sprintf("could not inject per-key label/annotation diffs: %s", err.Error()),
+		)
+	}
+	return patchBytes, diags
+}
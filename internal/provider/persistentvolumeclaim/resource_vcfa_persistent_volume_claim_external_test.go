@@ -0,0 +1,131 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package persistentvolumeclaim_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+// TestAccVcfaPersistentVolumeClaimResourceExternal exercises the full lifecycle
+// (create → expand → import → destroy) of the vcfa_persistent_volume_claim resource
+// against a live environment.
+func TestAccVcfaPersistentVolumeClaimResourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	// Kubernetes resource names must be lowercase DNS subdomains.
+	name := strings.ReplaceAll(strings.ToLower(t.Name()), "_", "-")
+
+	params := testutils.StringMap{
+		"Project":      cfg.VmService.Project,
+		"Namespace":    cfg.VmService.Namespace,
+		"Name":         name,
+		"StorageClass": cfg.VmService.StorageClass,
+		"Size":         "1Gi",
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText1 := testutils.TemplateFill(t, testAccVcfaPersistentVolumeClaimExternalConfig, params)
+	params["FuncName"] = t.Name() + "-expand"
+	params["Size"] = "2Gi"
+	configText2 := testutils.TemplateFill(t, testAccVcfaPersistentVolumeClaimExternalConfig, params)
+	params["FuncName"] = t.Name() + "-invalid-storage-class"
+	params["StorageClass"] = "non-existent-storage-class"
+	configText3 := testutils.TemplateFill(t, testAccVcfaPersistentVolumeClaimExternalConfig, params)
+
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: create a claim and wait for it to be bound.
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vcfa_persistent_volume_claim.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "name", name),
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "storage_class", cfg.VmService.StorageClass),
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "size", "1Gi"),
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "access_modes.#", "1"),
+					resource.TestCheckTypeSetElemAttr("vcfa_persistent_volume_claim.test", "access_modes.*", "ReadWriteOnce"),
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "volume_mode", "Filesystem"),
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "status.phase", "Bound"),
+					resource.TestCheckResourceAttrSet("vcfa_persistent_volume_claim.test", "status.volume_name"),
+					resource.TestCheckResourceAttrSet("vcfa_persistent_volume_claim.test", "metadata.uid"),
+				),
+			},
+			// Step 2: expand the claim in place.
+			{
+				Config: configText2,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vcfa_persistent_volume_claim.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "size", "2Gi"),
+					resource.TestCheckResourceAttr("vcfa_persistent_volume_claim.test", "status.phase", "Bound"),
+				),
+			},
+			// Step 3: a storage class that is not available in the namespace fails at plan time.
+			{
+				Config:      configText3,
+				ExpectError: regexp.MustCompile(`Invalid storage class`),
+			},
+			// Step 4: import and verify the state round-trips cleanly.
+			{
+				Config:            configText2,
+				ResourceName:      "vcfa_persistent_volume_claim.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["Project"].(string) + vcfa.ImportSeparator + params["Namespace"].(string) + vcfa.ImportSeparator + params["Name"].(string), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					"wait_for",    // local-only
+					"timeouts",    // local-only
+					"labels",      // user-managed subset, unknown on import
+					"annotations", // user-managed subset, unknown on import
+					"metadata",    // computed-only
+					"status",      // computed-only
+				},
+			},
+		},
+	})
+}
+
+// testAccVcfaPersistentVolumeClaimExternalConfig is the HCL template of every step.
+const testAccVcfaPersistentVolumeClaimExternalConfig = `
+resource "vcfa_persistent_volume_claim" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  name          = "{{.Name}}"
+  storage_class = "{{.StorageClass}}"
+  size          = "{{.Size}}"
+
+  wait_for = {
+    bound   = true
+    deleted = true
+  }
+}
+`
@@ -0,0 +1,131 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package persistentvolumeclaim
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// ── API → Terraform state ────────────────────────────────────────────────────
+
+// mapPersistentVolumeClaimToResourceModel maps the PersistentVolumeClaim returned by the API
+// into model. The size already in model is kept when it denotes the same quantity as the
+// requested one, so that the canonical form returned by the API (e.g. "1Gi" for "1024Mi")
+// is not reported as a diff.
+func mapPersistentVolumeClaimToResourceModel(ctx context.Context, pvc *vcfatypes.PersistentVolumeClaim, model *vcfaPersistentVolumeClaimResourceModel, diags *diag.Diagnostics) {
+	model.Metadata = helpers.ObjFrom(ctx, kubernetes.MetadataAttrTypes,
+		kubernetes.MapMetadataToModel(ctx, pvc.ObjectMeta, diags), diags)
+
+	storageClass := ""
+	if pvc.Spec.StorageClassName != nil {
+		storageClass = *pvc.Spec.StorageClassName
+	}
+	model.StorageClass = types.StringValue(storageClass)
+
+	if requested, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		if !sameQuantity(model.Size, requested) {
+			model.Size = types.StringValue(requested.String())
+		}
+	} else {
+		model.Size = types.StringNull()
+	}
+
+	model.AccessModes = accessModesToSet(ctx, pvc.Spec.AccessModes, diags)
+
+	volumeMode := corev1.PersistentVolumeFilesystem
+	if pvc.Spec.VolumeMode != nil {
+		volumeMode = *pvc.Spec.VolumeMode
+	}
+	model.VolumeMode = types.StringValue(string(volumeMode))
+
+	status := persistentVolumeClaimStatusModel{
+		Phase:       helpers.StringOrNull(string(pvc.Status.Phase)),
+		VolumeName:  helpers.StringOrNull(pvc.Spec.VolumeName),
+		Capacity:    types.StringNull(),
+		AccessModes: accessModesToSet(ctx, pvc.Status.AccessModes, diags),
+	}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		status.Capacity = types.StringValue(capacity.String())
+	}
+	model.Status = helpers.ObjFrom(ctx, persistentVolumeClaimStatusAttrTypes, status, diags)
+}
+
+func accessModesToSet(ctx context.Context, modes []corev1.PersistentVolumeAccessMode, diags *diag.Diagnostics) types.Set {
+	values := make([]string, 0, len(modes))
+	for _, m := range modes {
+		values = append(values, string(m))
+	}
+	set, d := types.SetValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return set
+}
+
+// sameQuantity reports whether size holds a quantity equal to q
+func sameQuantity(size types.String, q resource.Quantity) bool {
+	if size.IsNull() || size.IsUnknown() {
+		return false
+	}
+	parsed, err := resource.ParseQuantity(size.ValueString())
+	if err != nil {
+		return false
+	}
+	return parsed.Cmp(q) == 0
+}
+
+// ── Terraform plan → API ─────────────────────────────────────────────────────
+
+func mapResourceModelToPersistentVolumeClaim(ctx context.Context, model *vcfaPersistentVolumeClaimResourceModel, diags *diag.Diagnostics) *vcfatypes.PersistentVolumeClaim {
+	vcfContext := common.ExtractVcfContext(ctx, model.Context, diags)
+
+	pvc := &vcfatypes.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vcfatypes.CoreVersion,
+			Kind:       vcfatypes.PersistentVolumeClaimKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        model.Name.ValueString(),
+			Namespace:   vcfContext.Namespace.ValueString(),
+			Labels:      helpers.ExtractStringMap(ctx, model.Labels, diags),
+			Annotations: helpers.ExtractStringMap(ctx, model.Annotations, diags),
+		},
+	}
+
+	storageClass := model.StorageClass.ValueString()
+	pvc.Spec.StorageClassName = &storageClass
+
+	volumeMode := corev1.PersistentVolumeMode(model.VolumeMode.ValueString())
+	pvc.Spec.VolumeMode = &volumeMode
+
+	if !model.AccessModes.IsNull() && !model.AccessModes.IsUnknown() {
+		var modes []string
+		diags.Append(model.AccessModes.ElementsAs(ctx, &modes, false)...)
+		for _, m := range modes {
+			pvc.Spec.AccessModes = append(pvc.Spec.AccessModes, corev1.PersistentVolumeAccessMode(m))
+		}
+	}
+
+	if !model.Size.IsNull() && !model.Size.IsUnknown() {
+		size, err := resource.ParseQuantity(model.Size.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("size"), "Invalid resource quantity", err.Error())
+			return pvc
+		}
+		pvc.Spec.Resources.Requests = corev1.ResourceList{corev1.ResourceStorage: size}
+	}
+
+	return pvc
+}
@@ -0,0 +1,65 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package persistentvolumeclaim
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ── Resource Top-level model ─────────────────────────────────────────────────
+
+type vcfaPersistentVolumeClaimResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Context types.Object `tfsdk:"context"`
+	Name    types.String `tfsdk:"name"`
+
+	// Wait controls
+	WaitFor types.Object `tfsdk:"wait_for"`
+
+	// Timeouts
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	// Metadata
+	Metadata types.Object `tfsdk:"metadata"`
+
+	// User-managed labels and annotations on the claim's ObjectMeta.
+	// Only the keys the user specifies are tracked; backend-injected entries are ignored.
+	Labels      types.Map `tfsdk:"labels"`
+	Annotations types.Map `tfsdk:"annotations"`
+
+	// Spec fields
+	StorageClass types.String `tfsdk:"storage_class"`
+	Size         types.String `tfsdk:"size"`
+	AccessModes  types.Set    `tfsdk:"access_modes"`
+	VolumeMode   types.String `tfsdk:"volume_mode"`
+
+	// Status
+	Status types.Object `tfsdk:"status"`
+}
+
+// ── Wait controls ────────────────────────────────────────────────────────────
+
+type persistentVolumeClaimWaitForModel struct {
+	Bound   types.Bool `tfsdk:"bound"`
+	Deleted types.Bool `tfsdk:"deleted"`
+}
+
+// ── Status ───────────────────────────────────────────────────────────────────
+
+type persistentVolumeClaimStatusModel struct {
+	Phase       types.String `tfsdk:"phase"`
+	VolumeName  types.String `tfsdk:"volume_name"`
+	Capacity    types.String `tfsdk:"capacity"`
+	AccessModes types.Set    `tfsdk:"access_modes"`
+}
+
+var persistentVolumeClaimStatusAttrTypes = map[string]attr.Type{
+	"phase":        types.StringType,
+	"volume_name":  types.StringType,
+	"capacity":     types.StringType,
+	"access_modes": types.SetType{ElemType: types.StringType},
+}
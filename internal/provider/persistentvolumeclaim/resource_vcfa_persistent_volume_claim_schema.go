@@ -0,0 +1,190 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package persistentvolumeclaim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/validators"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+var persistentVolumeClaimAccessModes = []string{
+	string(corev1.ReadWriteOnce),
+	string(corev1.ReadOnlyMany),
+	string(corev1.ReadWriteMany),
+	string(corev1.ReadWriteOncePod),
+}
+
+func (r *vcfaPersistentVolumeClaimResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Resource for managing a %s, which provisions storage in a Supervisor Namespace.", vcfatypes.LabelPersistentVolumeClaim),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Internal identifier of the %s", vcfatypes.LabelPersistentVolumeClaim),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Required attributes
+			"context": common.VcfContextResourceSchema,
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s (must be RFC 1123 DNS subdomain compliant)", vcfatypes.LabelPersistentVolumeClaim),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kubernetes.ReDNSSubdomain, "must be a valid DNS subdomain"),
+				},
+			},
+
+			// Wait attributes
+			"wait_for": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Controls whether certain operations block until the claim reaches a certain state",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"bound": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: fmt.Sprintf("When true, Create and Update operations block until the claim reaches the %s phase", corev1.ClaimBound),
+					},
+					"deleted": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "When true, Delete operation blocks until the claim is fully removed. Set to false (default) to return immediately after the delete API call.",
+					},
+				},
+			},
+
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+
+			// Metadata attributes
+			"metadata": kubernetes.MetadataResourceSchema,
+
+			// User-managed claim metadata. Only the keys explicitly set here are tracked in
+			// Terraform state; any additional labels/annotations injected by the backend are
+			// silently ignored and will never appear in the plan diff.
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed labels to set on the claim's ObjectMeta. Keys not present here are not tracked, so backend-injected labels are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed annotations to set on the claim's ObjectMeta. Keys not present here are not tracked, so backend-injected annotations are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Spec attributes
+			"storage_class": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the storage class used to provision the volume. It must be one of the `storage_classes` of the Supervisor Namespace",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"size": schema.StringAttribute{
+				Required:    true,
+				Description: "Requested size of the volume, as a Kubernetes resource quantity (e.g. \"10Gi\"). Increasing it expands the volume in place, decreasing it replaces the claim",
+				Validators: []validator.String{
+					validators.IsValidQuantity(),
+				},
+			},
+			"access_modes": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue(string(corev1.ReadWriteOnce))})),
+				Description: fmt.Sprintf("Access modes of the volume. Defaults to [\"%s\"]", corev1.ReadWriteOnce),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(persistentVolumeClaimAccessModes...)),
+				},
+			},
+			"volume_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(corev1.PersistentVolumeFilesystem)),
+				Description: fmt.Sprintf("Whether the volume is mounted with a file system ('%s', default) or consumed as a raw block device ('%s')", corev1.PersistentVolumeFilesystem, corev1.PersistentVolumeBlock),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(corev1.PersistentVolumeFilesystem), string(corev1.PersistentVolumeBlock)),
+				},
+			},
+
+			// Status attributes
+			"status": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Observed state of the %s", vcfatypes.LabelPersistentVolumeClaim),
+				Attributes: map[string]schema.Attribute{
+					"phase": schema.StringAttribute{
+						Computed:    true,
+						Description: "Phase of the claim: Pending, Bound or Lost",
+					},
+					"volume_name": schema.StringAttribute{
+						Computed:    true,
+						Description: "Name of the PersistentVolume bound to the claim",
+					},
+					"capacity": schema.StringAttribute{
+						Computed:    true,
+						Description: "Actual capacity of the bound volume",
+					},
+					"access_modes": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "Actual access modes of the bound volume",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,115 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package persistentvolumeclaim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func extractWaitForBound(ctx context.Context, waitForObj types.Object) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return false, diags
+	}
+	var wf persistentVolumeClaimWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	return wf.Bound.ValueBool(), diags
+}
+
+func extractWaitForDeleted(ctx context.Context, waitForObj types.Object) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return false, diags
+	}
+	var wf persistentVolumeClaimWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	return wf.Deleted.ValueBool(), diags
+}
+
+// waitForPersistentVolumeClaimBound polls the claim until it reaches the Bound phase and
+// returns its latest observed state. A claim that is Lost never gets bound again, so the
+// wait stops right away in that case.
+func waitForPersistentVolumeClaimBound(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, timeout time.Duration, diags *diag.Diagnostics) (*vcfatypes.PersistentVolumeClaim, error) {
+	var lastPhase corev1.PersistentVolumeClaimPhase
+	conf := &retry.StateChangeConf{
+		Pending:      []string{string(corev1.ClaimPending), ""},
+		Target:       []string{string(corev1.ClaimBound)},
+		Timeout:      timeout,
+		PollInterval: persistentVolumeClaimPollInterval,
+		Refresh: func() (any, string, error) {
+			var pvc vcfatypes.PersistentVolumeClaim
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetPersistentVolumeClaimGVR(), &pvc); err != nil {
+				if apierrors.IsNotFound(err) {
+					return nil, "", fmt.Errorf("%s %s in VCF context %s/%s not found while waiting to be bound", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace)
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to be bound: %w", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace, err)
+			}
+
+			lastPhase = pvc.Status.Phase
+			if pvc.Status.Phase == corev1.ClaimLost {
+				return nil, "", fmt.Errorf("%s %s in VCF context %s/%s lost its underlying volume", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace)
+			}
+			log.Printf("[DEBUG] %s %s in VCF context %s/%s current phase is %q", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace, pvc.Status.Phase)
+			return &pvc, string(pvc.Status.Phase), nil
+		},
+	}
+
+	result, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			diags.AddWarning(
+				fmt.Sprintf("%s %s is not bound yet", vcfatypes.LabelPersistentVolumeClaim, name),
+				fmt.Sprintf("the claim is still in phase %q. Storage classes with the WaitForFirstConsumer binding mode only bind a claim once a workload uses it", lastPhase),
+			)
+		}
+		return nil, fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be bound: %w", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace, err)
+	}
+	return result.(*vcfatypes.PersistentVolumeClaim), nil
+}
+
+func waitForPersistentVolumeClaimDeleted(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, deleteTimeout time.Duration) error {
+	const (
+		persistentVolumeClaimStateExists  = "Exists"
+		persistentVolumeClaimStateDeleted = "Deleted"
+	)
+
+	conf := &retry.StateChangeConf{
+		Pending:      []string{persistentVolumeClaimStateExists},
+		Target:       []string{persistentVolumeClaimStateDeleted},
+		Timeout:      deleteTimeout,
+		PollInterval: persistentVolumeClaimPollInterval,
+		Refresh: func() (any, string, error) {
+			var pvc vcfatypes.PersistentVolumeClaim
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetPersistentVolumeClaimGVR(), &pvc); err != nil {
+				if apierrors.IsNotFound(err) {
+					return "", persistentVolumeClaimStateDeleted, nil
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to be deleted: %w", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace, err)
+			}
+			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to be deleted (deletionTimestamp: %s - finalizers: %s)", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace, pvc.DeletionTimestamp, pvc.Finalizers)
+			return &pvc, persistentVolumeClaimStateExists, nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be deleted: %w", vcfatypes.LabelPersistentVolumeClaim, name, projectName, namespace, err)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (d *vcfaProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Data source for reading a %s of the Organization of the session", vcfatypes.LabelProject),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Internal identifier of the %s", vcfatypes.LabelProject),
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s", vcfatypes.LabelProject),
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Description of the %s", vcfatypes.LabelProject),
+			},
+		},
+	}
+}
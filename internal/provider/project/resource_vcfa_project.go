@@ -0,0 +1,150 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ resource.Resource                = (*vcfaProjectResource)(nil)
+	_ resource.ResourceWithConfigure   = (*vcfaProjectResource)(nil)
+	_ resource.ResourceWithImportState = (*vcfaProjectResource)(nil)
+)
+
+type vcfaProjectResource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaProjectResource() resource.Resource {
+	return &vcfaProjectResource{}
+}
+
+func (r *vcfaProjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *vcfaProjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving TM client from provider data", err.Error())
+		return
+	}
+	r.tmClient = tmClient
+}
+
+func (r *vcfaProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vcfaProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	created, err := helpers.CreateProject(r.tmClient, mapResourceModelToProject(&plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelProject, name),
+			fmt.Sprintf("could not create %s %s in organization %s: %s", vcfatypes.LabelProject, name, r.tmClient.Org, err.Error()),
+		)
+		return
+	}
+
+	mapProjectToResourceModel(created, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vcfaProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	project, err := helpers.GetProject(r.tmClient, name)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelProject, name),
+			fmt.Sprintf("could not read %s %s in organization %s: %s", vcfatypes.LabelProject, name, r.tmClient.Org, err.Error()),
+		)
+		return
+	}
+
+	mapProjectToResourceModel(project, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vcfaProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vcfaProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	// The update replaces the whole object, so it starts from the live one to keep its
+	// resource version and the fields that are not managed by Terraform
+	project, err := helpers.GetProject(r.tmClient, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelProject, name),
+			fmt.Sprintf("could not read %s %s in organization %s: %s", vcfatypes.LabelProject, name, r.tmClient.Org, err.Error()),
+		)
+		return
+	}
+	project.Spec.Description = plan.Description.ValueString()
+
+	updated, err := helpers.UpdateProject(r.tmClient, project)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelProject, name),
+			fmt.Sprintf("could not update %s %s in organization %s: %s", vcfatypes.LabelProject, name, r.tmClient.Org, err.Error()),
+		)
+		return
+	}
+
+	mapProjectToResourceModel(updated, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vcfaProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if err := helpers.DeleteProject(r.tmClient, name); err != nil && !govcd.ContainsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelProject, name),
+			fmt.Sprintf("could not delete %s %s in organization %s: %s", vcfatypes.LabelProject, name, r.tmClient.Org, err.Error()),
+		)
+	}
+}
+
+func (r *vcfaProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
@@ -0,0 +1,85 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package project_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+)
+
+// TestAccVcfaProjectResourceExternal exercises the full lifecycle
+// (create → update → import → destroy) of the vcfa_project resource and
+// reads it back with the vcfa_project data source.
+func TestAccVcfaProjectResourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	// Project names must be lowercase DNS labels.
+	name := strings.ReplaceAll(strings.ToLower(t.Name()), "_", "-")
+
+	params := testutils.StringMap{
+		"Name":        name,
+		"Description": "created by Terraform",
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText1 := testutils.TemplateFill(t, testAccVcfaProjectExternalConfig, params)
+	params["FuncName"] = t.Name() + "-update"
+	params["Description"] = "updated by Terraform"
+	configText2 := testutils.TemplateFill(t, testAccVcfaProjectExternalConfig, params)
+
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: create the project and read it with the data source.
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_project.test", "id", name),
+					resource.TestCheckResourceAttr("vcfa_project.test", "name", name),
+					resource.TestCheckResourceAttr("vcfa_project.test", "description", "created by Terraform"),
+					resource.TestCheckResourceAttrPair("data.vcfa_project.test", "id", "vcfa_project.test", "id"),
+					resource.TestCheckResourceAttrPair("data.vcfa_project.test", "description", "vcfa_project.test", "description"),
+				),
+			},
+			// Step 2: update the description in place.
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_project.test", "id", name),
+					resource.TestCheckResourceAttr("vcfa_project.test", "description", "updated by Terraform"),
+				),
+			},
+			// Step 3: import and verify the state round-trips cleanly.
+			{
+				ResourceName:      "vcfa_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     name,
+			},
+		},
+	})
+}
+
+// testAccVcfaProjectExternalConfig is the HCL template of every step.
+const testAccVcfaProjectExternalConfig = `
+resource "vcfa_project" "test" {
+  name        = "{{.Name}}"
+  description = "{{.Description}}"
+}
+
+data "vcfa_project" "test" {
+  name = vcfa_project.test.name
+}
+`
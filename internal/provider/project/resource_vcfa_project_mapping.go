@@ -0,0 +1,35 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package project
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func mapResourceModelToProject(model *vcfaProjectResourceModel) ccitypes.Project {
+	return ccitypes.Project{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       ccitypes.ProjectKind,
+			APIVersion: ccitypes.ProjectAPI + "/" + ccitypes.ProjectVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: model.Name.ValueString(),
+		},
+		Spec: ccitypes.ProjectSpec{
+			Description: model.Description.ValueString(),
+		},
+	}
+}
+
+func mapProjectToResourceModel(project ccitypes.Project, model *vcfaProjectResourceModel) {
+	model.ID = types.StringValue(project.Name)
+	model.Name = types.StringValue(project.Name)
+	model.Description = types.StringNull()
+	if project.Spec.Description != "" {
+		model.Description = types.StringValue(project.Spec.Description)
+	}
+}
@@ -0,0 +1,195 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package projectrolebinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ resource.Resource                = (*vcfaProjectRoleBindingResource)(nil)
+	_ resource.ResourceWithConfigure   = (*vcfaProjectRoleBindingResource)(nil)
+	_ resource.ResourceWithImportState = (*vcfaProjectRoleBindingResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*vcfaProjectRoleBindingResource)(nil)
+)
+
+type vcfaProjectRoleBindingResource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaProjectRoleBindingResource() resource.Resource {
+	return &vcfaProjectRoleBindingResource{}
+}
+
+func (r *vcfaProjectRoleBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_role_binding"
+}
+
+func (r *vcfaProjectRoleBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving TM client from provider data", err.Error())
+		return
+	}
+	r.tmClient = tmClient
+}
+
+func (r *vcfaProjectRoleBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vcfaProjectRoleBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := plan.ProjectName.ValueString()
+	binding := mapResourceModelToProjectRoleBinding(&plan)
+	created, err := helpers.CreateProjectRoleBinding(r.tmClient, projectName, binding)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelProjectRoleBinding, binding.Name),
+			fmt.Sprintf("could not create %s %s in %s %s: %s", vcfatypes.LabelProjectRoleBinding, binding.Name, vcfatypes.LabelProject, projectName, err.Error()),
+		)
+		return
+	}
+
+	if err := mapProjectRoleBindingToResourceModel(created, projectName, &plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error reading %s %s", vcfatypes.LabelProjectRoleBinding, binding.Name), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaProjectRoleBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vcfaProjectRoleBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.ProjectName.ValueString()
+	name := vcfatypes.ProjectRoleBindingName(state.PrincipalType.ValueString(), state.PrincipalName.ValueString())
+	binding, err := helpers.GetProjectRoleBinding(r.tmClient, projectName, name)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelProjectRoleBinding, name),
+			fmt.Sprintf("could not read %s %s in %s %s: %s", vcfatypes.LabelProjectRoleBinding, name, vcfatypes.LabelProject, projectName, err.Error()),
+		)
+		return
+	}
+
+	if err := mapProjectRoleBindingToResourceModel(binding, projectName, &state); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("error reading %s %s", vcfatypes.LabelProjectRoleBinding, name), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with changes, as every attribute requires replacement
+func (r *vcfaProjectRoleBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vcfaProjectRoleBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaProjectRoleBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vcfaProjectRoleBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectName := state.ProjectName.ValueString()
+	name := vcfatypes.ProjectRoleBindingName(state.PrincipalType.ValueString(), state.PrincipalName.ValueString())
+	if err := helpers.DeleteProjectRoleBinding(r.tmClient, projectName, name); err != nil && !govcd.ContainsNotFound(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelProjectRoleBinding, name),
+			fmt.Sprintf("could not delete %s %s in %s %s: %s", vcfatypes.LabelProjectRoleBinding, name, vcfatypes.LabelProject, projectName, err.Error()),
+		)
+	}
+}
+
+func (r *vcfaProjectRoleBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, vcfa.ImportSeparator, 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("expected import ID in the format project%[1]sprincipal_type%[1]sprincipal_name, got: %s", vcfa.ImportSeparator, req.ID),
+		)
+		return
+	}
+	if _, ok := vcfatypes.ProjectRoleBindingSubjectKinds[parts[1]]; !ok {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("principal type must be '%s' or '%s', got: %s", vcfatypes.PrincipalTypeUser, vcfatypes.PrincipalTypeGroup, parts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_name"), parts[2])...)
+}
+
+// ModifyPlan checks that the principal of a new binding exists in the identity source of the
+// Organization.
+func (r *vcfaProjectRoleBindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy, nor before the provider is configured
+	if req.Plan.Raw.IsNull() || r.tmClient == nil {
+		return
+	}
+
+	var plan vcfaProjectRoleBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.PrincipalType.IsUnknown() || plan.PrincipalName.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state vcfaProjectRoleBindingResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.PrincipalType.Equal(plan.PrincipalType) && state.PrincipalName.Equal(plan.PrincipalName) {
+			return
+		}
+	}
+
+	principalType := plan.PrincipalType.ValueString()
+	principalName := plan.PrincipalName.ValueString()
+	var err error
+	switch principalType {
+	case vcfatypes.PrincipalTypeUser:
+		err = helpers.CheckOrgUserExists(r.tmClient, principalName)
+	case vcfatypes.PrincipalTypeGroup:
+		err = helpers.CheckOrgGroupExists(r.tmClient, principalName)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("principal_name"),
+			"Invalid principal",
+			fmt.Sprintf("cannot grant %s access to %s %s: %s", plan.Role.ValueString(), principalType, principalName, err),
+		)
+	}
+}
@@ -0,0 +1,101 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package projectrolebinding_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+// TestAccVcfaProjectRoleBindingResourceExternal grants a role on a new
+// project to the test user, changes the role (which replaces the binding),
+// imports it, and checks that unknown principals are rejected at plan time.
+func TestAccVcfaProjectRoleBindingResourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	// Project names must be lowercase DNS labels.
+	name := strings.ReplaceAll(strings.ToLower(t.Name()), "_", "-")
+
+	params := testutils.StringMap{
+		"Project":       name,
+		"PrincipalName": cfg.Org.User,
+		"Role":          "view",
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText1 := testutils.TemplateFill(t, testAccVcfaProjectRoleBindingExternalConfig, params)
+	params["FuncName"] = t.Name() + "-update"
+	params["Role"] = "edit"
+	configText2 := testutils.TemplateFill(t, testAccVcfaProjectRoleBindingExternalConfig, params)
+	params["FuncName"] = t.Name() + "-invalid"
+	params["PrincipalName"] = name + "-does-not-exist"
+	configText3 := testutils.TemplateFill(t, testAccVcfaProjectRoleBindingExternalConfig, params)
+
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: create the project and grant the view role.
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_project_role_binding.test", "id", name+vcfa.ImportSeparator+"user"+vcfa.ImportSeparator+cfg.Org.User),
+					resource.TestCheckResourceAttr("vcfa_project_role_binding.test", "project_name", name),
+					resource.TestCheckResourceAttr("vcfa_project_role_binding.test", "principal_type", "user"),
+					resource.TestCheckResourceAttr("vcfa_project_role_binding.test", "principal_name", cfg.Org.User),
+					resource.TestCheckResourceAttr("vcfa_project_role_binding.test", "role", "view"),
+				),
+			},
+			// Step 2: change the role.
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_project_role_binding.test", "role", "edit"),
+				),
+			},
+			// Step 3: import and verify the state round-trips cleanly.
+			{
+				ResourceName:      "vcfa_project_role_binding.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     name + vcfa.ImportSeparator + "user" + vcfa.ImportSeparator + cfg.Org.User,
+			},
+			// Step 4: a principal that does not exist is rejected during plan.
+			{
+				Config:      configText3,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid principal`),
+			},
+		},
+	})
+}
+
+// testAccVcfaProjectRoleBindingExternalConfig is the HCL template of every step.
+const testAccVcfaProjectRoleBindingExternalConfig = `
+resource "vcfa_project" "test" {
+  name = "{{.Project}}"
+}
+
+resource "vcfa_project_role_binding" "test" {
+  project_name   = vcfa_project.test.name
+  principal_type = "user"
+  principal_name = "{{.PrincipalName}}"
+  role           = "{{.Role}}"
+}
+`
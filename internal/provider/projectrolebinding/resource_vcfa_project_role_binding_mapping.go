@@ -0,0 +1,73 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package projectrolebinding
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+func buildID(projectName, principalType, principalName string) string {
+	return projectName + vcfa.ImportSeparator + principalType + vcfa.ImportSeparator + principalName
+}
+
+func mapResourceModelToProjectRoleBinding(model *vcfaProjectRoleBindingResourceModel) vcfatypes.ProjectRoleBinding {
+	principalType := model.PrincipalType.ValueString()
+	principalName := model.PrincipalName.ValueString()
+
+	return vcfatypes.ProjectRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       vcfatypes.ProjectRoleBindingKind,
+			APIVersion: vcfatypes.ProjectRoleBindingAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vcfatypes.ProjectRoleBindingName(principalType, principalName),
+			Namespace: model.ProjectName.ValueString(),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: vcfatypes.AuthorizationGroup,
+			Kind:     vcfatypes.ProjectRoleKind,
+			Name:     model.Role.ValueString(),
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind: vcfatypes.ProjectRoleBindingSubjectKinds[principalType],
+				Name: principalName,
+			},
+		},
+	}
+}
+
+// mapProjectRoleBindingToResourceModel sets the role and principal of the given binding in the model.
+// The binding must have exactly one user or group subject, which is how it is created by this resource.
+func mapProjectRoleBindingToResourceModel(binding vcfatypes.ProjectRoleBinding, projectName string, model *vcfaProjectRoleBindingResourceModel) error {
+	if len(binding.Subjects) != 1 {
+		return fmt.Errorf("%s %s has %d subjects, expected 1", vcfatypes.LabelProjectRoleBinding, binding.Name, len(binding.Subjects))
+	}
+	subject := binding.Subjects[0]
+
+	principalType := ""
+	for t, kind := range vcfatypes.ProjectRoleBindingSubjectKinds {
+		if kind == subject.Kind {
+			principalType = t
+		}
+	}
+	if principalType == "" {
+		return fmt.Errorf("%s %s has a subject of unsupported kind %s", vcfatypes.LabelProjectRoleBinding, binding.Name, subject.Kind)
+	}
+
+	model.ID = types.StringValue(buildID(projectName, principalType, subject.Name))
+	model.ProjectName = types.StringValue(projectName)
+	model.PrincipalType = types.StringValue(principalType)
+	model.PrincipalName = types.StringValue(subject.Name)
+	model.Role = types.StringValue(binding.RoleRef.Name)
+	return nil
+}
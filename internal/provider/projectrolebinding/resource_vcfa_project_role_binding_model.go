@@ -0,0 +1,17 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package projectrolebinding
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vcfaProjectRoleBindingResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectName   types.String `tfsdk:"project_name"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalName types.String `tfsdk:"principal_name"`
+	Role          types.String `tfsdk:"role"`
+}
@@ -0,0 +1,76 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package projectrolebinding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (r *vcfaProjectRoleBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Resource for granting a role on a %s to a user or a group of the Organization", vcfatypes.LabelProject),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Internal identifier of the %s", vcfatypes.LabelProjectRoleBinding),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s the role is granted on", vcfatypes.LabelProject),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Type of the principal: '%s' or '%s'", vcfatypes.PrincipalTypeUser, vcfatypes.PrincipalTypeGroup),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(vcfatypes.PrincipalTypeUser, vcfatypes.PrincipalTypeGroup),
+				},
+			},
+			"principal_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the user or group, as known to the identity source of the Organization",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				Description: fmt.Sprintf("Role granted on the %s: '%s', '%s' or '%s'", vcfatypes.LabelProject,
+					vcfatypes.ProjectRoleAdmin, vcfatypes.ProjectRoleEdit, vcfatypes.ProjectRoleView),
+				PlanModifiers: []planmodifier.String{
+					// The role reference of a binding cannot be changed once created
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(vcfatypes.ProjectRoleAdmin, vcfatypes.ProjectRoleEdit, vcfatypes.ProjectRoleView),
+				},
+			},
+		},
+	}
+}
@@ -15,9 +15,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/namespacesecret"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/persistentvolumeclaim"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/project"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/projectrolebinding"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/supervisornamespacerolebindings"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/supervisornamespaceusage"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/virtualmachine"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/virtualmachineservice"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/vkscluster"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/vksclusterclass"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/vksclusterkubeconfig"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/vksclustermachines"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/vkskubernetesrelease"
 )
 
@@ -141,16 +150,28 @@ func (p *VcfaFrameworkProvider) Configure(_ context.Context, req provider.Config
 // Resources returns the list of framework-based resources.
 func (p *VcfaFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		namespacesecret.NewVcfaNamespaceSecretResource,
+		persistentvolumeclaim.NewVcfaPersistentVolumeClaimResource,
+		project.NewVcfaProjectResource,
+		projectrolebinding.NewVcfaProjectRoleBindingResource,
+		supervisornamespacerolebindings.NewVcfaSupervisorNamespaceRoleBindingsResource,
 		vkscluster.NewVcfaVksClusterResource,
+		virtualmachine.NewVcfaVirtualMachineResource,
+		virtualmachineservice.NewVcfaVirtualMachineServiceResource,
 	}
 }
 
 // DataSources returns the list of framework-based data sources.
 func (p *VcfaFrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		project.NewVcfaProjectDataSource,
+		supervisornamespaceusage.NewVcfaSupervisorNamespaceUsageDataSource,
 		vksclusterclass.NewVcfaVksClusterClassDataSource,
+		vksclusterclass.NewVcfaVksClusterClassesDataSource,
 		vkscluster.NewVcfaVksClusterDataSource,
+		vkscluster.NewVcfaVksClustersDataSource,
 		vkskubernetesrelease.NewVcfaVksKubernetesReleaseDataSource,
 		vksclusterkubeconfig.NewVcfaVksClusterKubeconfigDataSource,
+		vksclustermachines.NewVcfaVksClusterMachinesDataSource,
 	}
 }
@@ -0,0 +1,306 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespacerolebindings
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ resource.Resource                = (*vcfaSupervisorNamespaceRoleBindingsResource)(nil)
+	_ resource.ResourceWithConfigure   = (*vcfaSupervisorNamespaceRoleBindingsResource)(nil)
+	_ resource.ResourceWithImportState = (*vcfaSupervisorNamespaceRoleBindingsResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*vcfaSupervisorNamespaceRoleBindingsResource)(nil)
+)
+
+type vcfaSupervisorNamespaceRoleBindingsResource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaSupervisorNamespaceRoleBindingsResource() resource.Resource {
+	return &vcfaSupervisorNamespaceRoleBindingsResource{}
+}
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supervisor_namespace_role_bindings"
+}
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving TM client from provider data", err.Error())
+		return
+	}
+	r.tmClient = tmClient
+}
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vcfaSupervisorNamespaceRoleBindingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	desired := entriesFromSet(ctx, plan.Bindings, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := reconcileAccess(ctx, k8sClient, namespace, nil, desired, plan.Authoritative.ValueBool()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("could not reconcile %s in VCF context %s/%s: %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", project, namespace))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vcfaSupervisorNamespaceRoleBindingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	current, err := readCurrentAccess(ctx, k8sClient, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("could not read %s in VCF context %s/%s: %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	// Imported resources are authoritative by default
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+
+	var entries []roleBindingEntry
+	if state.Authoritative.ValueBool() {
+		entries = current.entries()
+	} else {
+		// Only the entries managed by this resource are tracked; entries that were
+		// revoked outside of Terraform show up as a diff and are granted again.
+		for _, e := range entriesFromSet(ctx, state.Bindings, &resp.Diagnostics) {
+			if _, ok := current[e]; ok {
+				entries = append(entries, e)
+			}
+		}
+	}
+
+	state.Bindings = entriesToSet(ctx, entries, &resp.Diagnostics)
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", project, namespace))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state vcfaSupervisorNamespaceRoleBindingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan vcfaSupervisorNamespaceRoleBindingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	previous := entriesFromSet(ctx, state.Bindings, &resp.Diagnostics)
+	desired := entriesFromSet(ctx, plan.Bindings, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := reconcileAccess(ctx, k8sClient, namespace, previous, desired, plan.Authoritative.ValueBool()); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("could not reconcile %s in VCF context %s/%s: %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", project, namespace))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vcfaSupervisorNamespaceRoleBindingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	current, err := readCurrentAccess(ctx, k8sClient, namespace)
+	if err == nil {
+		// Only the access recorded in the state is revoked, in both modes
+		err = revokeAccess(ctx, k8sClient, namespace, current, entriesFromSet(ctx, state.Bindings, &resp.Diagnostics))
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s of namespace %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, namespace),
+			fmt.Sprintf("could not revoke %s in VCF context %s/%s: %s", vcfatypes.LabelSupervisorNamespaceRoleBindings, project, namespace, err.Error()),
+		)
+	}
+}
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, vcfa.ImportSeparator, 3)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"invalid import ID format",
+			fmt.Sprintf("expected project%snamespace, got: %s", vcfa.ImportSeparator, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("namespace"), parts[1])...)
+}
+
+// ModifyPlan checks that the principals of the bindings added by the plan exist in the
+// identity source of the Organization.
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy, nor before the provider is configured
+	if req.Plan.Raw.IsNull() || r.tmClient == nil {
+		return
+	}
+
+	var plan vcfaSupervisorNamespaceRoleBindingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Bindings.IsUnknown() {
+		return
+	}
+
+	known := map[roleBindingEntry]bool{}
+	if !req.State.Raw.IsNull() {
+		var state vcfaSupervisorNamespaceRoleBindingsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		for _, e := range entriesFromSet(ctx, state.Bindings, &resp.Diagnostics) {
+			known[e] = true
+		}
+	}
+
+	checked := map[string]bool{}
+	for _, e := range entriesFromSet(ctx, plan.Bindings, &resp.Diagnostics) {
+		key := e.principalType + ":" + e.principalName
+		if known[e] || checked[key] || e.principalName == "" {
+			continue
+		}
+		checked[key] = true
+		validatePrincipal(r.tmClient, e, &resp.Diagnostics)
+	}
+}
+
+func validatePrincipal(tmClient *vcfa.VCDClient, e roleBindingEntry, diags *diag.Diagnostics) {
+	var err error
+	switch e.principalType {
+	case vcfatypes.PrincipalTypeUser:
+		err = helpers.CheckOrgUserExists(tmClient, e.principalName)
+	case vcfatypes.PrincipalTypeGroup:
+		err = helpers.CheckOrgGroupExists(tmClient, e.principalName)
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("bindings"),
+			"Invalid principal",
+			fmt.Sprintf("cannot grant %s access to %s %s: %s", e.role, e.principalType, e.principalName, err),
+		)
+	}
+}
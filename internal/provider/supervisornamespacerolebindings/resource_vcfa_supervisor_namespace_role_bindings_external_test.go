@@ -0,0 +1,118 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespacerolebindings_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+// TestAccVcfaSupervisorNamespaceRoleBindingsResourceExternal exercises the lifecycle
+// (grant → change role → import → revoke) of the vcfa_supervisor_namespace_role_bindings
+// resource in additive mode, so that the access of other principals is left untouched.
+func TestAccVcfaSupervisorNamespaceRoleBindingsResourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	params := testutils.StringMap{
+		"Project":       cfg.VmService.Project,
+		"Namespace":     cfg.VmService.Namespace,
+		"PrincipalName": cfg.Org.User,
+		"Role":          "view",
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText1 := testutils.TemplateFill(t, testAccVcfaSupervisorNamespaceRoleBindingsExternalConfig, params)
+	params["FuncName"] = t.Name() + "-update"
+	params["Role"] = "edit"
+	configText2 := testutils.TemplateFill(t, testAccVcfaSupervisorNamespaceRoleBindingsExternalConfig, params)
+	params["FuncName"] = t.Name() + "-invalid-principal"
+	params["PrincipalName"] = "non-existent-user-" + t.Name()
+	configText3 := testutils.TemplateFill(t, testAccVcfaSupervisorNamespaceRoleBindingsExternalConfig, params)
+
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: grant view access to the Org user.
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vcfa_supervisor_namespace_role_bindings.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace_role_bindings.test", "authoritative", "false"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace_role_bindings.test", "bindings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_supervisor_namespace_role_bindings.test", "bindings.*", map[string]string{
+						"role":           "view",
+						"principal_type": "user",
+						"principal_name": cfg.Org.User,
+					}),
+				),
+			},
+			// Step 2: change the access to edit.
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace_role_bindings.test", "bindings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_supervisor_namespace_role_bindings.test", "bindings.*", map[string]string{
+						"role": "edit",
+					}),
+				),
+			},
+			// Step 3: principals that do not exist in the Organization fail at plan time.
+			{
+				Config:      configText3,
+				ExpectError: regexp.MustCompile(`Invalid principal`),
+			},
+			// Step 4: import. Imported resources are authoritative, so the bindings include
+			// every principal with access to the namespace.
+			{
+				Config:            configText2,
+				ResourceName:      "vcfa_supervisor_namespace_role_bindings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["Project"].(string) + vcfa.ImportSeparator + params["Namespace"].(string), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					"authoritative", // true on import
+					"bindings",      // every principal with access on import
+				},
+			},
+		},
+	})
+}
+
+// testAccVcfaSupervisorNamespaceRoleBindingsExternalConfig is the HCL template of every step.
+const testAccVcfaSupervisorNamespaceRoleBindingsExternalConfig = `
+resource "vcfa_supervisor_namespace_role_bindings" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  authoritative = false
+
+  bindings = [
+    {
+      role           = "{{.Role}}"
+      principal_type = "user"
+      principal_name = "{{.PrincipalName}}"
+    },
+  ]
+}
+`
@@ -0,0 +1,130 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespacerolebindings
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// roleBindingEntry is a single access grant of a principal on the namespace
+type roleBindingEntry struct {
+	role          string
+	principalType string
+	principalName string
+}
+
+func (e roleBindingEntry) String() string {
+	return fmt.Sprintf("%s %s: %s", e.principalType, e.principalName, e.role)
+}
+
+// subjectKinds maps the principal types to the kinds of RBAC subjects
+var subjectKinds = map[string]string{
+	vcfatypes.PrincipalTypeUser:  rbacv1.UserKind,
+	vcfatypes.PrincipalTypeGroup: rbacv1.GroupKind,
+}
+
+// ── API → Terraform state ────────────────────────────────────────────────────
+
+// entriesOfRoleBinding returns the access grants of the given RoleBinding. RoleBindings that
+// do not bind one of the ClusterRoles of the namespace access levels, and subjects other than
+// users and groups, are not part of the access managed by this resource.
+func entriesOfRoleBinding(rb *vcfatypes.RoleBinding) []roleBindingEntry {
+	if rb.RoleRef.Kind != "ClusterRole" {
+		return nil
+	}
+	role := ""
+	for r, clusterRole := range vcfatypes.SupervisorNamespaceRoleClusterRoles {
+		if clusterRole == rb.RoleRef.Name {
+			role = r
+		}
+	}
+	if role == "" {
+		return nil
+	}
+
+	var entries []roleBindingEntry
+	for _, s := range rb.Subjects {
+		for principalType, kind := range subjectKinds {
+			if s.Kind == kind {
+				entries = append(entries, roleBindingEntry{role: role, principalType: principalType, principalName: s.Name})
+			}
+		}
+	}
+	return entries
+}
+
+func entriesToSet(ctx context.Context, entries []roleBindingEntry, diags *diag.Diagnostics) types.Set {
+	sort.Slice(entries, func(i, j int) bool { return entries[i].String() < entries[j].String() })
+	models := make([]roleBindingModel, 0, len(entries))
+	for _, e := range entries {
+		models = append(models, roleBindingModel{
+			Role:          types.StringValue(e.role),
+			PrincipalType: types.StringValue(e.principalType),
+			PrincipalName: types.StringValue(e.principalName),
+		})
+	}
+	set, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: roleBindingAttrTypes}, models)
+	diags.Append(d...)
+	return set
+}
+
+// ── Terraform plan → API ─────────────────────────────────────────────────────
+
+func entriesFromSet(ctx context.Context, set types.Set, diags *diag.Diagnostics) []roleBindingEntry {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	var models []roleBindingModel
+	diags.Append(set.ElementsAs(ctx, &models, false)...)
+	entries := make([]roleBindingEntry, 0, len(models))
+	for _, m := range models {
+		entries = append(entries, roleBindingEntry{
+			role:          m.Role.ValueString(),
+			principalType: m.PrincipalType.ValueString(),
+			principalName: m.PrincipalName.ValueString(),
+		})
+	}
+	return entries
+}
+
+// roleBindingFromEntry builds the RoleBinding that grants a single access entry. Its name is
+// derived from the entry, so that the same entry always maps to the same RoleBinding.
+func roleBindingFromEntry(namespace string, e roleBindingEntry) *vcfatypes.RoleBinding {
+	hash := sha256.Sum256([]byte(e.principalName))
+	return &vcfatypes.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vcfatypes.RoleBindingAPIVersion,
+			Kind:       vcfatypes.RoleBindingKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("vcfa-%s-%s-%s", e.role, e.principalType, hex.EncodeToString(hash[:])[:10]),
+			Namespace: namespace,
+			Labels: map[string]string{
+				vcfatypes.ManagedByLabel: vcfatypes.ManagedByLabelValue,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: vcfatypes.RbacGroup,
+			Kind:     "ClusterRole",
+			Name:     vcfatypes.SupervisorNamespaceRoleClusterRoles[e.role],
+		},
+		Subjects: []rbacv1.Subject{{
+			APIGroup: vcfatypes.RbacGroup,
+			Kind:     subjectKinds[e.principalType],
+			Name:     e.principalName,
+		}},
+	}
+}
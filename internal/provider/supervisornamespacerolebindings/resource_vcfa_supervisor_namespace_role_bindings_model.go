@@ -0,0 +1,33 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespacerolebindings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ── Resource Top-level model ─────────────────────────────────────────────────
+
+type vcfaSupervisorNamespaceRoleBindingsResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Context       types.Object `tfsdk:"context"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	Bindings      types.Set    `tfsdk:"bindings"`
+}
+
+// ── Bindings ─────────────────────────────────────────────────────────────────
+
+type roleBindingModel struct {
+	Role          types.String `tfsdk:"role"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalName types.String `tfsdk:"principal_name"`
+}
+
+var roleBindingAttrTypes = map[string]attr.Type{
+	"role":           types.StringType,
+	"principal_type": types.StringType,
+	"principal_name": types.StringType,
+}
@@ -0,0 +1,154 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespacerolebindings
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// currentAccess holds the access grants found in a namespace, with the RoleBindings that
+// grant each of them
+type currentAccess map[roleBindingEntry][]*vcfatypes.RoleBinding
+
+func (c currentAccess) entries() []roleBindingEntry {
+	entries := make([]roleBindingEntry, 0, len(c))
+	for e := range c {
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// readCurrentAccess returns the access grants found in a namespace. RoleBindings owned by the
+// platform are not included, so they are never reported nor revoked by this resource.
+func readCurrentAccess(ctx context.Context, k8sClient *kubernetes.Client, namespace string) (currentAccess, error) {
+	var list vcfatypes.RoleBindingList
+	if err := k8sClient.ListNamespaceScopedResources(ctx, namespace, vcfatypes.GetRoleBindingGVR(), "", &list); err != nil {
+		return nil, err
+	}
+
+	current := currentAccess{}
+	for i := range list.Items {
+		rb := &list.Items[i]
+		if isPlatformOwnedRoleBinding(rb) {
+			log.Printf("[DEBUG] skipping RoleBinding %s in namespace %s, as it is owned by the platform", rb.Name, namespace)
+			continue
+		}
+		for _, e := range entriesOfRoleBinding(rb) {
+			current[e] = append(current[e], rb)
+		}
+	}
+	return current, nil
+}
+
+// isPlatformOwnedRoleBinding returns true for the RoleBindings that VCFA or the Supervisor
+// create, i.e. for the owners of the namespace and the members of its project. These are
+// recognized by having owner references, by being managed by another tool, or by having
+// system labels.
+func isPlatformOwnedRoleBinding(rb *vcfatypes.RoleBinding) bool {
+	if len(rb.OwnerReferences) > 0 {
+		return true
+	}
+	for key, value := range rb.Labels {
+		if key == vcfatypes.ManagedByLabel && value != vcfatypes.ManagedByLabelValue {
+			return true
+		}
+		if vcfatypes.IsSystemLabel(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// reconcileAccess grants every desired entry that is missing and revokes the entries that
+// are not desired anymore. In authoritative mode, every entry that is not desired is revoked;
+// otherwise only the previously managed entries are.
+func reconcileAccess(ctx context.Context, k8sClient *kubernetes.Client, namespace string, previous []roleBindingEntry, desired []roleBindingEntry, authoritative bool) error {
+	current, err := readCurrentAccess(ctx, k8sClient, namespace)
+	if err != nil {
+		return err
+	}
+
+	desiredSet := make(map[roleBindingEntry]bool, len(desired))
+	for _, e := range desired {
+		desiredSet[e] = true
+		if _, ok := current[e]; ok {
+			continue
+		}
+		log.Printf("[DEBUG] granting %s in namespace %s", e, namespace)
+		var created vcfatypes.RoleBinding
+		if err := k8sClient.CreateNamespaceScopedResource(ctx, vcfatypes.GetRoleBindingGVR(), namespace, roleBindingFromEntry(namespace, e), &created, false); err != nil {
+			return fmt.Errorf("could not grant %s: %w", e, err)
+		}
+	}
+
+	candidates := previous
+	if authoritative {
+		candidates = current.entries()
+	}
+	var revoked []roleBindingEntry
+	for _, e := range candidates {
+		if !desiredSet[e] {
+			revoked = append(revoked, e)
+		}
+	}
+	return revokeAccess(ctx, k8sClient, namespace, current, revoked)
+}
+
+// revokeAccess removes the given entries from the RoleBindings that grant them. RoleBindings
+// that are left without subjects are deleted.
+func revokeAccess(ctx context.Context, k8sClient *kubernetes.Client, namespace string, current currentAccess, revoked []roleBindingEntry) error {
+	// Group the entries by RoleBinding, so that each of them is changed only once
+	revokedByRoleBinding := map[string]map[roleBindingEntry]bool{}
+	roleBindings := map[string]*vcfatypes.RoleBinding{}
+	for _, e := range revoked {
+		for _, rb := range current[e] {
+			if revokedByRoleBinding[rb.Name] == nil {
+				revokedByRoleBinding[rb.Name] = map[roleBindingEntry]bool{}
+			}
+			revokedByRoleBinding[rb.Name][e] = true
+			roleBindings[rb.Name] = rb
+		}
+	}
+
+	for name, entries := range revokedByRoleBinding {
+		rb := roleBindings[name]
+		var kept []rbacv1.Subject
+		for _, s := range rb.Subjects {
+			keep := true
+			for e := range entries {
+				if s.Kind == subjectKinds[e.principalType] && s.Name == e.principalName {
+					keep = false
+				}
+			}
+			if keep {
+				kept = append(kept, s)
+			}
+		}
+
+		if len(kept) == 0 {
+			log.Printf("[DEBUG] deleting RoleBinding %s in namespace %s", name, namespace)
+			if err := k8sClient.DeleteNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetRoleBindingGVR(), false); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("could not delete RoleBinding %s: %w", name, err)
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] removing %d subjects from RoleBinding %s in namespace %s", len(rb.Subjects)-len(kept), name, namespace)
+		rb.Subjects = kept
+		var updated vcfatypes.RoleBinding
+		if err := k8sClient.UpdateNamespaceScopedResource(ctx, vcfatypes.GetRoleBindingGVR(), namespace, rb, &updated, false); err != nil {
+			return fmt.Errorf("could not update RoleBinding %s: %w", name, err)
+		}
+	}
+	return nil
+}
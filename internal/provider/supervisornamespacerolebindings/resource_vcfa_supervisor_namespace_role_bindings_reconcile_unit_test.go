@@ -0,0 +1,51 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespacerolebindings
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func TestIsPlatformOwnedRoleBinding(t *testing.T) {
+	type testCase struct {
+		name            string
+		labels          map[string]string
+		ownerReferences []metav1.OwnerReference
+		expected        bool
+	}
+
+	testCases := []testCase{
+		{name: "NoLabels"},
+		{name: "UserLabels", labels: map[string]string{"team": "blue", "example.com/owner": "me"}},
+		{name: "ManagedByProvider", labels: map[string]string{vcfatypes.ManagedByLabel: vcfatypes.ManagedByLabelValue}},
+		{name: "ManagedByOtherTool", labels: map[string]string{vcfatypes.ManagedByLabel: "helm"}, expected: true},
+		{name: "OwnerReferences", ownerReferences: []metav1.OwnerReference{{Kind: "SupervisorNamespace", Name: "ns"}}, expected: true},
+		{name: "SystemLabel", labels: map[string]string{"vmware-system-user-access": "true"}, expected: true},
+		{name: "SystemDomainLabel", labels: map[string]string{"iaas.vmware.com/owner": "admin"}, expected: true},
+		{name: "LookalikeDomainLabel", labels: map[string]string{"vmware.community/owner": "admin"}},
+		{name: "LookalikePrefixLabel", labels: map[string]string{"vmware-systematic": "true"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rb := &vcfatypes.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "binding",
+					Labels:          tc.labels,
+					OwnerReferences: tc.ownerReferences,
+				},
+			}
+			if got := isPlatformOwnedRoleBinding(rb); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespacerolebindings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (r *vcfaSupervisorNamespaceRoleBindingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Resource for managing the %s, which grant VCFA users and groups access to a Supervisor Namespace.", vcfatypes.LabelSupervisorNamespaceRoleBindings),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Internal identifier of the %s", vcfatypes.LabelSupervisorNamespaceRoleBindings),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Required attributes
+			"context": common.VcfContextResourceSchema,
+
+			"authoritative": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "When true (default), `bindings` is the complete list of users and groups with view, edit or owner access to the " +
+					"Supervisor Namespace, and any other such access is revoked, except the access owned by the platform. When false, only the listed bindings are added and managed",
+			},
+			"bindings": schema.SetNestedAttribute{
+				Required:    true,
+				Description: "Access granted to users and groups of the Organization",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:    true,
+							Description: fmt.Sprintf("Access level granted: '%s', '%s' or '%s'", vcfatypes.SupervisorNamespaceRoleView, vcfatypes.SupervisorNamespaceRoleEdit, vcfatypes.SupervisorNamespaceRoleOwner),
+							Validators: []validator.String{
+								stringvalidator.OneOf(vcfatypes.SupervisorNamespaceRoleView, vcfatypes.SupervisorNamespaceRoleEdit, vcfatypes.SupervisorNamespaceRoleOwner),
+							},
+						},
+						"principal_type": schema.StringAttribute{
+							Required:    true,
+							Description: fmt.Sprintf("Type of the principal: '%s' or '%s'", vcfatypes.PrincipalTypeUser, vcfatypes.PrincipalTypeGroup),
+							Validators: []validator.String{
+								stringvalidator.OneOf(vcfatypes.PrincipalTypeUser, vcfatypes.PrincipalTypeGroup),
+							},
+						},
+						"principal_name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the user or group. It must exist in the identity source of the Organization",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,125 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespaceusage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+const labelSupervisorNamespace = "Supervisor Namespace"
+
+var (
+	_ datasource.DataSource              = (*vcfaSupervisorNamespaceUsageDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*vcfaSupervisorNamespaceUsageDataSource)(nil)
+)
+
+type vcfaSupervisorNamespaceUsageDataSource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaSupervisorNamespaceUsageDataSource() datasource.DataSource {
+	return &vcfaSupervisorNamespaceUsageDataSource{}
+}
+
+func (d *vcfaSupervisorNamespaceUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supervisor_namespace_usage"
+}
+
+func (d *vcfaSupervisorNamespaceUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting TM client", err.Error())
+		return
+	}
+	d.tmClient = tmClient
+}
+
+func (d *vcfaSupervisorNamespaceUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vcfaSupervisorNamespaceUsageModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, data.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	errorSummary := fmt.Sprintf("error reading usage of %s %s", labelSupervisorNamespace, namespace)
+
+	var inventory namespaceInventory
+	var err error
+	inventory.supervisorNamespace, err = helpers.GetSupervisorNamespace(d.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary, err.Error())
+		return
+	}
+
+	kubernetesClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			errorSummary,
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(kubernetesClient.FlushWarnings()...) }()
+
+	var virtualMachines vcfatypes.VirtualMachineList
+	var virtualMachineClasses vcfatypes.VirtualMachineClassList
+	var vksClusters vcfatypes.VksClusterList
+	var persistentVolumeClaims vcfatypes.PersistentVolumeClaimList
+	var resourceQuotas vcfatypes.ResourceQuotaList
+
+	lists := []struct {
+		label string
+		gvr   schema.GroupVersionResource
+		out   any
+	}{
+		{vcfatypes.LabelVirtualMachines, vcfatypes.GetVirtualMachineGVR(), &virtualMachines},
+		{vcfatypes.LabelVirtualMachineClasses, vcfatypes.GetVirtualMachineClassGVR(), &virtualMachineClasses},
+		{vcfatypes.LabelVksClusters, vcfatypes.GetVksClusterGVR(), &vksClusters},
+		{vcfatypes.LabelPersistentVolumeClaims, vcfatypes.GetPersistentVolumeClaimGVR(), &persistentVolumeClaims},
+		{vcfatypes.LabelResourceQuotas, vcfatypes.GetResourceQuotaGVR(), &resourceQuotas},
+	}
+	for _, l := range lists {
+		if err := kubernetesClient.ListNamespaceScopedResources(ctx, namespace, l.gvr, "", l.out); err != nil {
+			resp.Diagnostics.AddError(
+				errorSummary,
+				fmt.Sprintf("could not list %s in VCF context %s/%s: %s", l.label, project, namespace, err.Error()),
+			)
+			return
+		}
+	}
+
+	inventory.virtualMachines = virtualMachines.Items
+	inventory.virtualMachineClasses = virtualMachineClasses.Items
+	inventory.vksClusters = vksClusters.Items
+	inventory.persistentVolumeClaims = persistentVolumeClaims.Items
+	inventory.resourceQuotas = resourceQuotas.Items
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", project, namespace))
+	mapNamespaceUsageToModel(inventory, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,68 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespaceusage_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+)
+
+// TestAccVcfaSupervisorNamespaceUsageDatasourceExternal exercises the read path of the
+// vcfa_supervisor_namespace_usage data source against a live environment.
+func TestAccVcfaSupervisorNamespaceUsageDatasourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	params := testutils.StringMap{
+		"Project":   cfg.Vks.Project,
+		"Namespace": cfg.Vks.Namespace,
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText := testutils.TemplateFill(t, testAccVcfaSupervisorNamespaceUsageDatasourceExternalConfig, params)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION: %s\n", configText)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "id"),
+					resource.TestCheckResourceAttr("data.vcfa_supervisor_namespace_usage.test", "context.project", params["Project"].(string)),
+					resource.TestCheckResourceAttr("data.vcfa_supervisor_namespace_usage.test", "context.namespace", params["Namespace"].(string)),
+					testutils.CheckAttrNonEmptySet("data.vcfa_supervisor_namespace_usage.test", "zones.#"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "zones.0.name"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "zones.0.vcpus"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "zones.0.memory_used_mib"),
+					testutils.CheckAttrNonEmptySet("data.vcfa_supervisor_namespace_usage.test", "storage_classes.#"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "storage_classes.0.name"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "storage_classes.0.used_mib"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "virtual_machines"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "vks_clusters"),
+					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_namespace_usage.test", "persistent_volume_claims"),
+				),
+			},
+		},
+	})
+}
+
+// testAccVcfaSupervisorNamespaceUsageDatasourceExternalConfig is the HCL template for the
+// vcfa_supervisor_namespace_usage data source.
+const testAccVcfaSupervisorNamespaceUsageDatasourceExternalConfig = `
+data "vcfa_supervisor_namespace_usage" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+}
+`
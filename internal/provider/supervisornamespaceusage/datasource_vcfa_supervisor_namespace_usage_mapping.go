@@ -0,0 +1,155 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespaceusage
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	corev1 "k8s.io/api/core/v1"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+const mebibyte = 1024 * 1024
+
+// namespaceInventory holds the objects of a Supervisor Namespace the usage is computed from
+type namespaceInventory struct {
+	supervisorNamespace    ccitypes.SupervisorNamespace
+	virtualMachines        []vcfatypes.VirtualMachine
+	virtualMachineClasses  []vcfatypes.VirtualMachineClass
+	vksClusters            []vcfatypes.VksCluster
+	persistentVolumeClaims []vcfatypes.PersistentVolumeClaim
+	resourceQuotas         []vcfatypes.ResourceQuota
+}
+
+func mapNamespaceUsageToModel(inventory namespaceInventory, model *vcfaSupervisorNamespaceUsageModel) {
+	model.VirtualMachines = types.Int64Value(int64(len(inventory.virtualMachines)))
+	model.VksClusters = types.Int64Value(int64(len(inventory.vksClusters)))
+	model.PersistentVolumeClaims = types.Int64Value(int64(len(inventory.persistentVolumeClaims)))
+
+	model.Zones = []zoneUsageModel{}
+	model.StorageClasses = []storageClassUsageModel{}
+	if inventory.supervisorNamespace.Status == nil {
+		return
+	}
+
+	classes := make(map[string]vcfatypes.VirtualMachineClass, len(inventory.virtualMachineClasses))
+	for _, c := range inventory.virtualMachineClasses {
+		classes[c.Name] = c
+	}
+
+	for _, zone := range inventory.supervisorNamespace.Status.Zones {
+		usage := zoneUsageModel{
+			Name:                 types.StringValue(zone.Name),
+			CpuLimitMhz:          quantityToMhz(zone.CpuLimit),
+			CpuReservationMhz:    quantityToMhz(zone.CpuReservation),
+			MemoryLimitMib:       quantityToMib(zone.MemoryLimit),
+			MemoryReservationMib: quantityToMib(zone.MemoryReservation),
+		}
+
+		var vms, vcpus, memory, cpuReservation, memoryReservation int64
+		for _, vm := range inventory.virtualMachines {
+			if vm.Status.Zone != zone.Name {
+				continue
+			}
+			vms++
+
+			class, ok := classes[vm.Spec.ClassName]
+			if !ok {
+				log.Printf("[DEBUG] %s %s uses the unknown %s %s, its resources are not counted", vcfatypes.LabelVirtualMachine, vm.Name, vcfatypes.LabelVirtualMachineClass, vm.Spec.ClassName)
+				continue
+			}
+			vcpus += class.Spec.Hardware.Cpus
+			memory += class.Spec.Hardware.Memory.Value() / mebibyte
+			cpuReservation += class.Spec.Policies.Resources.Requests.Cpu.ScaledValue(apiresource.Mega)
+			memoryReservation += class.Spec.Policies.Resources.Requests.Memory.Value() / mebibyte
+		}
+		usage.VirtualMachines = types.Int64Value(vms)
+		usage.Vcpus = types.Int64Value(vcpus)
+		usage.MemoryUsedMib = types.Int64Value(memory)
+		usage.CpuReservationUsedMhz = types.Int64Value(cpuReservation)
+		usage.MemoryReservationUsedMib = types.Int64Value(memoryReservation)
+
+		model.Zones = append(model.Zones, usage)
+	}
+
+	for _, storageClass := range inventory.supervisorNamespace.Status.StorageClasses {
+		var claims int64
+		requested := apiresource.Quantity{}
+		for _, pvc := range inventory.persistentVolumeClaims {
+			if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != storageClass.Name {
+				continue
+			}
+			claims++
+			if size, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+				requested.Add(size)
+			}
+		}
+
+		// The quota of the storage class also accounts for the disks of the virtual machines,
+		// so it is preferred over the sum of the claims when the namespace has one
+		if used, ok := storageClassQuotaUsage(inventory.resourceQuotas, storageClass.Name); ok {
+			requested = used
+		}
+
+		model.StorageClasses = append(model.StorageClasses, storageClassUsageModel{
+			Name:                   types.StringValue(storageClass.Name),
+			LimitMib:               quantityToMib(storageClass.Limit),
+			UsedMib:                types.Int64Value(requested.Value() / mebibyte),
+			PersistentVolumeClaims: types.Int64Value(claims),
+		})
+	}
+}
+
+// storageClassQuotaUsage returns the storage requested from the given storage class, as
+// tracked by the ResourceQuotas of the namespace
+func storageClassQuotaUsage(quotas []vcfatypes.ResourceQuota, storageClassName string) (apiresource.Quantity, bool) {
+	key := corev1.ResourceName(storageClassName + vcfatypes.StorageClassQuotaSuffix)
+
+	var used apiresource.Quantity
+	found := false
+	for _, quota := range quotas {
+		if q, ok := quota.Status.Used[key]; ok && (!found || q.Cmp(used) > 0) {
+			used = q
+			found = true
+		}
+	}
+	return used, found
+}
+
+// quantityToMhz converts a CPU amount such as "1000M" or "2G", expressed in Hz, to MHz.
+// It returns null when the amount is empty or invalid.
+func quantityToMhz(value string) types.Int64 {
+	q, ok := parseQuantity(value)
+	if !ok {
+		return types.Int64Null()
+	}
+	return types.Int64Value(q.ScaledValue(apiresource.Mega))
+}
+
+// quantityToMib converts an amount of memory or storage such as "1000Mi" or "2Gi" to MiB.
+// It returns null when the amount is empty or invalid.
+func quantityToMib(value string) types.Int64 {
+	q, ok := parseQuantity(value)
+	if !ok {
+		return types.Int64Null()
+	}
+	return types.Int64Value(q.Value() / mebibyte)
+}
+
+func parseQuantity(value string) (apiresource.Quantity, bool) {
+	if value == "" {
+		return apiresource.Quantity{}, false
+	}
+	q, err := apiresource.ParseQuantity(value)
+	if err != nil {
+		log.Printf("[DEBUG] ignoring invalid quantity %q: %s", value, err)
+		return apiresource.Quantity{}, false
+	}
+	return q, true
+}
@@ -0,0 +1,40 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespaceusage
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vcfaSupervisorNamespaceUsageModel struct {
+	ID      types.String `tfsdk:"id"`
+	Context types.Object `tfsdk:"context"`
+
+	Zones                  []zoneUsageModel         `tfsdk:"zones"`
+	StorageClasses         []storageClassUsageModel `tfsdk:"storage_classes"`
+	VirtualMachines        types.Int64              `tfsdk:"virtual_machines"`
+	VksClusters            types.Int64              `tfsdk:"vks_clusters"`
+	PersistentVolumeClaims types.Int64              `tfsdk:"persistent_volume_claims"`
+}
+
+type zoneUsageModel struct {
+	Name                     types.String `tfsdk:"name"`
+	CpuLimitMhz              types.Int64  `tfsdk:"cpu_limit_mhz"`
+	CpuReservationMhz        types.Int64  `tfsdk:"cpu_reservation_mhz"`
+	CpuReservationUsedMhz    types.Int64  `tfsdk:"cpu_reservation_used_mhz"`
+	MemoryLimitMib           types.Int64  `tfsdk:"memory_limit_mib"`
+	MemoryReservationMib     types.Int64  `tfsdk:"memory_reservation_mib"`
+	MemoryReservationUsedMib types.Int64  `tfsdk:"memory_reservation_used_mib"`
+	MemoryUsedMib            types.Int64  `tfsdk:"memory_used_mib"`
+	Vcpus                    types.Int64  `tfsdk:"vcpus"`
+	VirtualMachines          types.Int64  `tfsdk:"virtual_machines"`
+}
+
+type storageClassUsageModel struct {
+	Name                   types.String `tfsdk:"name"`
+	LimitMib               types.Int64  `tfsdk:"limit_mib"`
+	UsedMib                types.Int64  `tfsdk:"used_mib"`
+	PersistentVolumeClaims types.Int64  `tfsdk:"persistent_volume_claims"`
+}
@@ -0,0 +1,116 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package supervisornamespaceusage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (d *vcfaSupervisorNamespaceUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Data source for reading the resource usage and capacity of a %s", labelSupervisorNamespace),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal identifier of the usage report",
+			},
+
+			// Lookup attributes
+			"context": common.VcfContextDataSourceSchema,
+
+			"zones": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("CPU and memory limits of the %s in each zone, and the amount allocated to its virtual machines", labelSupervisorNamespace),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the zone",
+						},
+						"cpu_limit_mhz": schema.Int64Attribute{
+							Computed:    true,
+							Description: "CPU limit of the zone in MHz. Null when the CPU is not limited",
+						},
+						"cpu_reservation_mhz": schema.Int64Attribute{
+							Computed:    true,
+							Description: "CPU reservation of the zone in MHz. Null when no CPU is reserved",
+						},
+						"cpu_reservation_used_mhz": schema.Int64Attribute{
+							Computed:    true,
+							Description: "CPU in MHz reserved by the virtual machines placed in the zone, as defined by their classes",
+						},
+						"memory_limit_mib": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Memory limit of the zone in MiB. Null when the memory is not limited",
+						},
+						"memory_reservation_mib": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Memory reservation of the zone in MiB. Null when no memory is reserved",
+						},
+						"memory_reservation_used_mib": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Memory in MiB reserved by the virtual machines placed in the zone, as defined by their classes",
+						},
+						"memory_used_mib": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Memory in MiB allocated to the virtual machines placed in the zone",
+						},
+						"vcpus": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of virtual CPUs allocated to the virtual machines placed in the zone",
+						},
+						"virtual_machines": schema.Int64Attribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Number of %s placed in the zone, including the nodes of %s", vcfatypes.LabelVirtualMachines, vcfatypes.LabelVksClusters),
+						},
+					},
+				},
+			},
+			"storage_classes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Storage limit of the %s for each storage class, and the amount of storage requested from it", labelSupervisorNamespace),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the storage class",
+						},
+						"limit_mib": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Storage limit of the storage class in MiB. Null when the storage is not limited",
+						},
+						"used_mib": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Storage in MiB requested from the storage class",
+						},
+						"persistent_volume_claims": schema.Int64Attribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Number of %s using the storage class", vcfatypes.LabelPersistentVolumeClaims),
+						},
+					},
+				},
+			},
+			"virtual_machines": schema.Int64Attribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Number of %s in the %s, including the nodes of %s", vcfatypes.LabelVirtualMachines, labelSupervisorNamespace, vcfatypes.LabelVksClusters),
+			},
+			"vks_clusters": schema.Int64Attribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Number of %s in the %s", vcfatypes.LabelVksClusters, labelSupervisorNamespace),
+			},
+			"persistent_volume_claims": schema.Int64Attribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Number of %s in the %s", vcfatypes.LabelPersistentVolumeClaims, labelSupervisorNamespace),
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type labelSelectorValidator struct{}
+
+// IsValidLabelSelector returns a validator.String that accepts any Kubernetes
+// label selector expression (e.g. "env=prod,tier in (web,api)").
+func IsValidLabelSelector() validator.String {
+	return labelSelectorValidator{}
+}
+
+func (v labelSelectorValidator) Description(_ context.Context) string {
+	return "must be a valid Kubernetes label selector (e.g. \"env=prod,tier in (web,api)\")"
+}
+
+func (v labelSelectorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v labelSelectorValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	val := req.ConfigValue.ValueString()
+	if _, err := labels.Parse(val); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid label selector",
+			fmt.Sprintf("%q is not a valid Kubernetes label selector: %s", val, err),
+		)
+	}
+}
@@ -0,0 +1,52 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+type quantityValidator struct{}
+
+// IsValidQuantity returns a validator.String that accepts any positive Kubernetes
+// resource quantity (e.g. "10Gi" or "500M").
+func IsValidQuantity() validator.String {
+	return quantityValidator{}
+}
+
+func (v quantityValidator) Description(_ context.Context) string {
+	return "must be a positive Kubernetes resource quantity (e.g. \"10Gi\")"
+}
+
+func (v quantityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v quantityValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	val := req.ConfigValue.ValueString()
+	q, err := resource.ParseQuantity(val)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid resource quantity",
+			fmt.Sprintf("%q is not a valid Kubernetes resource quantity: %s", val, err),
+		)
+		return
+	}
+	if q.Sign() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid resource quantity",
+			fmt.Sprintf("%q must be greater than zero", val),
+		)
+	}
+}
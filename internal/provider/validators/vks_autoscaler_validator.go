@@ -25,7 +25,8 @@ type vksMachineDeploymentHasScalingValidator struct{}
 
 // VksMachineDeploymentHasScaling returns a validator.Object that emits a
 // plan-time error when a machine_deployments entry specifies neither replicas
-// nor any autoscaler bound.
+// nor any autoscaler bound, or when it sets replicas on a pool managed by the
+// cluster autoscaler.
 func VksMachineDeploymentHasScaling() validator.Object {
 	return vksMachineDeploymentHasScalingValidator{}
 }
@@ -56,6 +57,19 @@ func (v vksMachineDeploymentHasScalingValidator) ValidateObject(_ context.Contex
 	// neither bound set is treated the same as no autoscaler block at all.
 	autoscalerHasBounds := autoscalerBoundsPresent(attrs)
 
+	// Setting the autoscaler annotations directly in metadata.annotations hands
+	// the replicas over to the cluster autoscaler just like the autoscaler block.
+	if replicasPresent && autoscalerAnnotationsPresent(attrs) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Conflicting scaling configuration",
+			fmt.Sprintf("\"replicas\" cannot be set when the annotations %q / %q are present in \"metadata.annotations\", "+
+				"as the replicas are then managed by the cluster autoscaler. Remove \"replicas\" and use \"autoscaler\" instead.",
+				vcfatypes.AutoscalerMinSizeAnnotationKey, vcfatypes.AutoscalerMaxSizeAnnotationKey),
+		)
+		return
+	}
+
 	if replicasPresent && autoscalerHasBounds {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
@@ -65,7 +79,7 @@ func (v vksMachineDeploymentHasScalingValidator) ValidateObject(_ context.Contex
 		return
 	}
 
-	if replicasPresent || autoscalerHasBounds {
+	if replicasPresent || autoscalerHasBounds || autoscalerAnnotationsPresent(attrs) {
 		return
 	}
 
@@ -98,6 +112,31 @@ func autoscalerBoundsPresent(attrs map[string]attr.Value) bool {
 	return (minOk && !minSize.IsNull()) || (maxOk && !maxSize.IsNull())
 }
 
+// autoscalerAnnotationsPresent returns true when metadata.annotations contains
+// any of the cluster autoscaler min/max size annotation keys.
+func autoscalerAnnotationsPresent(attrs map[string]attr.Value) bool {
+	metadataAttr, ok := attrs["metadata"]
+	if !ok || metadataAttr.IsNull() || metadataAttr.IsUnknown() {
+		return false
+	}
+	metaObj, ok := metadataAttr.(types.Object)
+	if !ok {
+		return false
+	}
+	annotationsAttr, ok := metaObj.Attributes()["annotations"]
+	if !ok || annotationsAttr.IsNull() || annotationsAttr.IsUnknown() {
+		return false
+	}
+	annotationsMap, ok := annotationsAttr.(types.Map)
+	if !ok {
+		return false
+	}
+	elements := annotationsMap.Elements()
+	_, hasMin := elements[vcfatypes.AutoscalerMinSizeAnnotationKey]
+	_, hasMax := elements[vcfatypes.AutoscalerMaxSizeAnnotationKey]
+	return hasMin || hasMax
+}
+
 // ── VksAutoscalerAnnotationConflict ──────────────────────────────────────────
 
 // vksAutoscalerAnnotationConflictValidator is a validator.Object that rejects
@@ -0,0 +1,434 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+const (
+	virtualMachineCreateDefaultTimeout  = 30 * time.Minute
+	virtualMachineUpdateDefaultTimeout  = 30 * time.Minute
+	virtualMachineDeleteDefaultTimeout  = 10 * time.Minute
+	virtualMachinePollInterval          = 5 * time.Second
+	virtualMachineConflictMaxRetries    = 5
+	virtualMachineConflictRetryInterval = 2 * time.Second
+)
+
+var (
+	_ resource.Resource                   = (*vcfaVirtualMachineResource)(nil)
+	_ resource.ResourceWithConfigure      = (*vcfaVirtualMachineResource)(nil)
+	_ resource.ResourceWithImportState    = (*vcfaVirtualMachineResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*vcfaVirtualMachineResource)(nil)
+)
+
+type vcfaVirtualMachineResource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaVirtualMachineResource() resource.Resource {
+	return &vcfaVirtualMachineResource{}
+}
+
+func (r *vcfaVirtualMachineResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine"
+}
+
+func (r *vcfaVirtualMachineResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving TM client from provider data", err.Error())
+		return
+	}
+	r.tmClient = tmClient
+}
+
+func (r *vcfaVirtualMachineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vcfaVirtualMachineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, virtualMachineCreateDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCriteria, diags := extractWaitForCriteria(ctx, plan.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	vmObj := mapResourceModelToVirtualMachine(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created vcfatypes.VirtualMachine
+	if err := k8sClient.CreateNamespaceScopedResource(ctx, vcfatypes.GetVirtualMachineGVR(), namespace, vmObj, &created, false); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not create %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	latest := &created
+	if !waitCriteria.isEmpty() {
+		ready, err := waitForVirtualMachineReady(ctx, k8sClient, project, namespace, name, createTimeout, waitCriteria, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s created but not yet ready", vcfatypes.LabelVirtualMachine, name),
+				fmt.Sprintf("%s %s in VCF context %s/%s was created but did not reach the state requested in wait_for: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+			)
+		} else {
+			latest = ready
+		}
+	}
+
+	mapVirtualMachineToResourceModel(ctx, latest, &plan, &resp.Diagnostics)
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaVirtualMachineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vcfaVirtualMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	var vm vcfatypes.VirtualMachine
+	if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineGVR(), &vm); err != nil {
+		if apierrors.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	mapVirtualMachineToResourceModel(ctx, &vm, &state, &resp.Diagnostics)
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	// Keep only the user-managed subset of labels/annotations so that
+	// backend-injected entries never appear as diffs in the plan.
+	state.Labels = kubernetes.FilterToUserManagedKeys(ctx, vm.Labels, state.Labels, &resp.Diagnostics)
+	state.Annotations = kubernetes.FilterToUserManagedKeys(ctx, vm.Annotations, state.Annotations, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vcfaVirtualMachineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state vcfaVirtualMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan vcfaVirtualMachineResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, virtualMachineUpdateDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitCriteria, diags := extractWaitForCriteria(ctx, plan.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	patchBytes, diags := createMergePatch(ctx, state, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var latest vcfatypes.VirtualMachine
+	// Only send the patch request if there are changes to apply.
+	if len(patchBytes) > 2 {
+		if err := k8sClient.MergePatchNamespaceScopedResource(ctx, vcfatypes.GetVirtualMachineGVR(), namespace, name, patchBytes, &latest, virtualMachineConflictMaxRetries, virtualMachineConflictRetryInterval); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachine, name),
+				fmt.Sprintf("could not patch %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+			)
+			return
+		}
+	} else if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineGVR(), &latest); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	if !waitCriteria.isEmpty() {
+		ready, err := waitForVirtualMachineReady(ctx, k8sClient, project, namespace, name, updateTimeout, waitCriteria, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s updated but not yet ready", vcfatypes.LabelVirtualMachine, name),
+				fmt.Sprintf("%s %s in VCF context %s/%s was updated but did not reach the state requested in wait_for: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+			)
+		} else {
+			latest = *ready
+		}
+	}
+
+	mapVirtualMachineToResourceModel(ctx, &latest, &plan, &resp.Diagnostics)
+
+	// The metadata changes with every update (e.g. resource_version); keep the planned
+	// value to prevent an inconsistent result. The next Read refreshes it.
+	plan.Metadata = state.Metadata
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaVirtualMachineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vcfaVirtualMachineResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, virtualMachineDeleteDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitDeleted, diags := extractWaitForDeleted(ctx, state.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	if err := k8sClient.DeleteNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineGVR(), false); err != nil {
+		if apierrors.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not delete %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	if waitDeleted {
+		if err := waitForVirtualMachineDeleted(ctx, k8sClient, project, namespace, name, deleteTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s deletion still in progress", vcfatypes.LabelVirtualMachine, name),
+				fmt.Sprintf("%s %s deletion in VCF context %s/%s was initiated but did not complete within the timeout: %s", vcfatypes.LabelVirtualMachine, name, project, namespace, err.Error()),
+			)
+		}
+	}
+}
+
+func (r *vcfaVirtualMachineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, vcfa.ImportSeparator, 4)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"invalid import ID format",
+			fmt.Sprintf("expected project%snamespace%sname, got: %s", vcfa.ImportSeparator, vcfa.ImportSeparator, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("namespace"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+func (r *vcfaVirtualMachineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data vcfaVirtualMachineResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A virtual machine that is not powered on never reports the PoweredOn state nor
+	// an IP address, so waiting for them would always end in a timeout.
+	if data.PowerState.IsNull() || data.PowerState.IsUnknown() || data.PowerState.ValueString() == vcfatypes.VirtualMachinePowerStateOn {
+		return
+	}
+	criteria, diags := extractWaitForCriteria(ctx, data.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if !criteria.isEmpty() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for"),
+			"Invalid wait_for configuration",
+			fmt.Sprintf("wait_for.powered_on and wait_for.ip_assigned require power_state to be %s, got %s", vcfatypes.VirtualMachinePowerStateOn, data.PowerState.ValueString()),
+		)
+	}
+}
+
+func createMergePatch(ctx context.Context, state vcfaVirtualMachineResourceModel, plan vcfaVirtualMachineResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := plan.Name.ValueString()
+
+	oldObj := mapResourceModelToVirtualMachine(ctx, &state, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+	oldJSON, err := json.Marshal(oldObj)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not marshal old state to JSON: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	newObj := mapResourceModelToVirtualMachine(ctx, &plan, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+	newJSON, err := json.Marshal(newObj)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not marshal new plan to JSON: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	// Compute the JSON Merge Patch (RFC 7396) from the diff between old and new, so
+	// that fields defaulted by the backend are never overwritten.
+	patchBytes, err := jsonpatch.CreateMergePatch(oldJSON, newJSON)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not compute merge patch: %s", err.Error()),
+		)
+		return patchBytes, diags
+	}
+
+	// Express label and annotation changes as per-key diffs so that removing the
+	// last user-managed key does not erase the backend-injected ones.
+	patchBytes, err = kubernetes.InjectPerKeyMapDiffs(ctx, patchBytes, state.Labels, plan.Labels, state.Annotations, plan.Annotations, &diags)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachine, name),
+			fmt.Sprintf("could not inject per-key label/annotation diffs: %s", err.Error()),
+		)
+	}
+	return patchBytes, diags
+}
@@ -0,0 +1,169 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachine_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+// TestAccVcfaVirtualMachineResourceExternal exercises the full lifecycle
+// (create → power off → import → plan → destroy) of the vcfa_virtual_machine resource
+// against a live environment.
+func TestAccVcfaVirtualMachineResourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	// Kubernetes resource names must be lowercase DNS subdomains.
+	vmName := strings.ReplaceAll(strings.ToLower(t.Name()), "_", "-")
+
+	params := testutils.StringMap{
+		"Project":      cfg.VmService.Project,
+		"Namespace":    cfg.VmService.Namespace,
+		"VmName":       vmName,
+		"VmClass":      cfg.VmService.VmClass,
+		"StorageClass": cfg.VmService.StorageClass,
+		"Image":        cfg.VmService.Image,
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText1 := testutils.TemplateFill(t, testAccVcfaVirtualMachineExternalConfig, params)
+	params["FuncName"] = t.Name() + "-update"
+	configText2 := testutils.TemplateFill(t, testAccVcfaVirtualMachineExternalConfigUpdate, params)
+
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: create a powered on virtual machine and wait for its IP address.
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "context.project", params["Project"].(string)),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "context.namespace", params["Namespace"].(string)),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "name", vmName),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "class_name", params["VmClass"].(string)),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "image", params["Image"].(string)),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "image_kind", "VirtualMachineImage"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "storage_class", params["StorageClass"].(string)),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "power_state", "PoweredOn"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "network_interfaces.0.name", "eth0"),
+					// The network of the interface is resolved by the backend
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine.test", "network_interfaces.0.network_name"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "status.power_state", "PoweredOn"),
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine.test", "status.primary_ip4"),
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine.test", "status.bios_uuid"),
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine.test", "metadata.uid"),
+				),
+			},
+			// Step 2: power the virtual machine off and add a label.
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "power_state", "PoweredOff"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "labels.%", "1"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine.test", "labels.env", "test"),
+				),
+			},
+			// Step 3: import and verify the state round-trips cleanly. The config omits
+			// network_name, which is resolved by the backend and read on import.
+			{
+				ResourceName:       "vcfa_virtual_machine.test",
+				ImportState:        true,
+				ImportStateVerify:  true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["Project"].(string) + vcfa.ImportSeparator + params["Namespace"].(string) + vcfa.ImportSeparator + params["VmName"].(string), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					"wait_for",    // local-only
+					"timeouts",    // local-only
+					"labels",      // user-managed subset, unknown on import
+					"annotations", // user-managed subset, unknown on import
+					"metadata",    // computed-only
+					"status",      // computed-only
+				},
+			},
+			// Step 4: the imported state does not replace the virtual machine when the
+			// config omits the networks resolved by the backend.
+			{
+				Config:   configText2,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccVcfaVirtualMachineExternalConfig is the Step 1 (create) HCL template.
+const testAccVcfaVirtualMachineExternalConfig = `
+resource "vcfa_virtual_machine" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  name          = "{{.VmName}}"
+  class_name    = "{{.VmClass}}"
+  image         = "{{.Image}}"
+  storage_class = "{{.StorageClass}}"
+
+  network_interfaces = [
+    {
+      name = "eth0"
+    },
+  ]
+
+  wait_for = {
+    powered_on  = true
+    ip_assigned = true
+    deleted     = true
+  }
+}
+`
+
+// testAccVcfaVirtualMachineExternalConfigUpdate is the Step 2 (update) HCL template.
+// It powers the virtual machine off and sets a label.
+const testAccVcfaVirtualMachineExternalConfigUpdate = `
+resource "vcfa_virtual_machine" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  name          = "{{.VmName}}"
+  class_name    = "{{.VmClass}}"
+  image         = "{{.Image}}"
+  storage_class = "{{.StorageClass}}"
+  power_state   = "PoweredOff"
+
+  labels = {
+    env = "test"
+  }
+
+  network_interfaces = [
+    {
+      name = "eth0"
+    },
+  ]
+
+  wait_for = {
+    deleted = true
+  }
+}
+`
@@ -0,0 +1,202 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachine
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// ── API → Terraform state ────────────────────────────────────────────────────
+
+// mapVirtualMachineToResourceModel maps the VirtualMachine returned by the API into model
+func mapVirtualMachineToResourceModel(ctx context.Context, vm *vcfatypes.VirtualMachine, model *vcfaVirtualMachineResourceModel, diags *diag.Diagnostics) {
+	model.Metadata = helpers.ObjFrom(ctx, kubernetes.MetadataAttrTypes,
+		kubernetes.MapMetadataToModel(ctx, vm.ObjectMeta, diags), diags)
+
+	model.ClassName = types.StringValue(vm.Spec.ClassName)
+	model.StorageClass = types.StringValue(vm.Spec.StorageClass)
+
+	imageKind := vcfatypes.VirtualMachineImageKind
+	imageName := vm.Spec.ImageName
+	if vm.Spec.Image != nil {
+		imageName = vm.Spec.Image.Name
+		if vm.Spec.Image.Kind != "" {
+			imageKind = vm.Spec.Image.Kind
+		}
+	}
+	model.Image = types.StringValue(imageName)
+	model.ImageKind = types.StringValue(imageKind)
+
+	powerState := vm.Spec.PowerState
+	if powerState == "" {
+		powerState = vcfatypes.VirtualMachinePowerStateOn
+	}
+	model.PowerState = types.StringValue(powerState)
+
+	model.Bootstrap = mapBootstrapToModel(ctx, vm.Spec.Bootstrap, diags)
+	model.NetworkInterfaces = mapNetworkInterfacesToModel(ctx, vm.Spec.Network, diags)
+	model.Status = mapVirtualMachineStatusToModel(ctx, vm, diags)
+}
+
+func mapBootstrapToModel(ctx context.Context, bootstrap *vcfatypes.VirtualMachineBootstrapSpec, diags *diag.Diagnostics) types.Object {
+	if bootstrap == nil {
+		return types.ObjectNull(virtualMachineBootstrapAttrTypes)
+	}
+
+	model := virtualMachineBootstrapModel{
+		CloudInit: types.ObjectNull(virtualMachineSecretKeyAttrTypes),
+		Sysprep:   types.ObjectNull(virtualMachineSecretKeyAttrTypes),
+	}
+	if bootstrap.CloudInit != nil && bootstrap.CloudInit.RawCloudConfig != nil {
+		model.CloudInit = helpers.ObjFrom(ctx, virtualMachineSecretKeyAttrTypes, virtualMachineSecretKeyModel{
+			SecretName: types.StringValue(bootstrap.CloudInit.RawCloudConfig.Name),
+			SecretKey:  types.StringValue(bootstrap.CloudInit.RawCloudConfig.Key),
+		}, diags)
+	}
+	if bootstrap.Sysprep != nil && bootstrap.Sysprep.RawSysprep != nil {
+		model.Sysprep = helpers.ObjFrom(ctx, virtualMachineSecretKeyAttrTypes, virtualMachineSecretKeyModel{
+			SecretName: types.StringValue(bootstrap.Sysprep.RawSysprep.Name),
+			SecretKey:  types.StringValue(bootstrap.Sysprep.RawSysprep.Key),
+		}, diags)
+	}
+	if model.CloudInit.IsNull() && model.Sysprep.IsNull() {
+		return types.ObjectNull(virtualMachineBootstrapAttrTypes)
+	}
+	return helpers.ObjFrom(ctx, virtualMachineBootstrapAttrTypes, model, diags)
+}
+
+// mapNetworkInterfacesToModel maps the network interfaces, including the networks that the
+// backend resolves for the interfaces that do not set one
+func mapNetworkInterfacesToModel(ctx context.Context, network *vcfatypes.VirtualMachineNetworkSpec, diags *diag.Diagnostics) types.List {
+	elemType := types.ObjectType{AttrTypes: virtualMachineNetworkInterfaceAttrTypes}
+	if network == nil || len(network.Interfaces) == 0 {
+		return types.ListNull(elemType)
+	}
+
+	interfaces := make([]virtualMachineNetworkInterfaceModel, 0, len(network.Interfaces))
+	for _, iface := range network.Interfaces {
+		m := virtualMachineNetworkInterfaceModel{
+			Name:              types.StringValue(iface.Name),
+			NetworkName:       types.StringNull(),
+			NetworkKind:       types.StringNull(),
+			NetworkAPIVersion: types.StringNull(),
+		}
+		if iface.Network != nil {
+			m.NetworkName = helpers.StringOrNull(iface.Network.Name)
+			m.NetworkKind = helpers.StringOrNull(iface.Network.Kind)
+			m.NetworkAPIVersion = helpers.StringOrNull(iface.Network.APIVersion)
+		}
+		interfaces = append(interfaces, m)
+	}
+
+	list, d := types.ListValueFrom(ctx, elemType, interfaces)
+	diags.Append(d...)
+	return list
+}
+
+func mapVirtualMachineStatusToModel(ctx context.Context, vm *vcfatypes.VirtualMachine, diags *diag.Diagnostics) types.Object {
+	status := virtualMachineStatusModel{
+		PowerState:   helpers.StringOrNull(vm.Status.PowerState),
+		PrimaryIP4:   types.StringNull(),
+		PrimaryIP6:   types.StringNull(),
+		UniqueID:     helpers.StringOrNull(vm.Status.UniqueID),
+		BiosUUID:     helpers.StringOrNull(vm.Status.BiosUUID),
+		InstanceUUID: helpers.StringOrNull(vm.Status.InstanceUUID),
+		Zone:         helpers.StringOrNull(vm.Status.Zone),
+		Conditions: helpers.SetFrom(ctx,
+			types.ObjectType{AttrTypes: kubernetes.ConditionAttrTypes},
+			kubernetes.MapConditionsToModel(ctx, vm.Status.Conditions, diags),
+			diags),
+	}
+	if vm.Status.Network != nil {
+		status.PrimaryIP4 = helpers.StringOrNull(vm.Status.Network.PrimaryIP4)
+		status.PrimaryIP6 = helpers.StringOrNull(vm.Status.Network.PrimaryIP6)
+	}
+	return helpers.ObjFrom(ctx, virtualMachineStatusAttrTypes, status, diags)
+}
+
+// ── Terraform plan → API ─────────────────────────────────────────────────────
+
+func mapResourceModelToVirtualMachine(ctx context.Context, model *vcfaVirtualMachineResourceModel, diags *diag.Diagnostics) *vcfatypes.VirtualMachine {
+	vcfContext := common.ExtractVcfContext(ctx, model.Context, diags)
+
+	vm := &vcfatypes.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vcfatypes.VirtualMachineAPIVersion,
+			Kind:       vcfatypes.VirtualMachineKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        model.Name.ValueString(),
+			Namespace:   vcfContext.Namespace.ValueString(),
+			Labels:      helpers.ExtractStringMap(ctx, model.Labels, diags),
+			Annotations: helpers.ExtractStringMap(ctx, model.Annotations, diags),
+		},
+		Spec: vcfatypes.VirtualMachineSpec{
+			Image: &vcfatypes.VirtualMachineImageRef{
+				Kind: model.ImageKind.ValueString(),
+				Name: model.Image.ValueString(),
+			},
+			ImageName:    model.Image.ValueString(),
+			ClassName:    model.ClassName.ValueString(),
+			StorageClass: model.StorageClass.ValueString(),
+			PowerState:   model.PowerState.ValueString(),
+		},
+	}
+
+	if !model.Bootstrap.IsNull() && !model.Bootstrap.IsUnknown() {
+		var bootstrap virtualMachineBootstrapModel
+		diags.Append(model.Bootstrap.As(ctx, &bootstrap, basetypes.ObjectAsOptions{})...)
+		vm.Spec.Bootstrap = &vcfatypes.VirtualMachineBootstrapSpec{}
+		if selector := mapSecretKeyFromModel(ctx, bootstrap.CloudInit, diags); selector != nil {
+			vm.Spec.Bootstrap.CloudInit = &vcfatypes.VirtualMachineBootstrapCloudInitSpec{RawCloudConfig: selector}
+		}
+		if selector := mapSecretKeyFromModel(ctx, bootstrap.Sysprep, diags); selector != nil {
+			vm.Spec.Bootstrap.Sysprep = &vcfatypes.VirtualMachineBootstrapSysprepSpec{RawSysprep: selector}
+		}
+	}
+
+	if !model.NetworkInterfaces.IsNull() && !model.NetworkInterfaces.IsUnknown() {
+		var interfaces []virtualMachineNetworkInterfaceModel
+		diags.Append(model.NetworkInterfaces.ElementsAs(ctx, &interfaces, false)...)
+		vm.Spec.Network = &vcfatypes.VirtualMachineNetworkSpec{}
+		for _, iface := range interfaces {
+			ifaceSpec := vcfatypes.VirtualMachineNetworkInterfaceSpec{Name: iface.Name.ValueString()}
+			if !iface.NetworkName.IsNull() && !iface.NetworkName.IsUnknown() {
+				ifaceSpec.Network = &vcfatypes.PartialObjectRef{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       iface.NetworkKind.ValueString(),
+						APIVersion: iface.NetworkAPIVersion.ValueString(),
+					},
+					Name: iface.NetworkName.ValueString(),
+				}
+			}
+			vm.Spec.Network.Interfaces = append(vm.Spec.Network.Interfaces, ifaceSpec)
+		}
+	}
+
+	return vm
+}
+
+func mapSecretKeyFromModel(ctx context.Context, obj types.Object, diags *diag.Diagnostics) *vcfatypes.SecretKeySelector {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	var m virtualMachineSecretKeyModel
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	return &vcfatypes.SecretKeySelector{
+		Name: m.SecretName.ValueString(),
+		Key:  m.SecretKey.ValueString(),
+	}
+}
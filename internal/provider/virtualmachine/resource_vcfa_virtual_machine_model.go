@@ -0,0 +1,125 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachine
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+)
+
+// ── Resource Top-level model ─────────────────────────────────────────────────
+
+type vcfaVirtualMachineResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Context types.Object `tfsdk:"context"`
+	Name    types.String `tfsdk:"name"`
+
+	// Wait controls
+	WaitFor types.Object `tfsdk:"wait_for"`
+
+	// Timeouts
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	// Metadata
+	Metadata types.Object `tfsdk:"metadata"`
+
+	// User-managed labels and annotations on the virtual machine's ObjectMeta.
+	// Only the keys the user specifies are tracked; backend-injected entries are ignored.
+	Labels      types.Map `tfsdk:"labels"`
+	Annotations types.Map `tfsdk:"annotations"`
+
+	// Spec fields
+	ClassName         types.String `tfsdk:"class_name"`
+	Image             types.String `tfsdk:"image"`
+	ImageKind         types.String `tfsdk:"image_kind"`
+	StorageClass      types.String `tfsdk:"storage_class"`
+	PowerState        types.String `tfsdk:"power_state"`
+	Bootstrap         types.Object `tfsdk:"bootstrap"`
+	NetworkInterfaces types.List   `tfsdk:"network_interfaces"`
+
+	// Status
+	Status types.Object `tfsdk:"status"`
+}
+
+// ── Wait controls ────────────────────────────────────────────────────────────
+
+type virtualMachineWaitForModel struct {
+	PoweredOn  types.Bool `tfsdk:"powered_on"`
+	IPAssigned types.Bool `tfsdk:"ip_assigned"`
+	Deleted    types.Bool `tfsdk:"deleted"`
+}
+
+// ── Bootstrap ────────────────────────────────────────────────────────────────
+
+type virtualMachineBootstrapModel struct {
+	CloudInit types.Object `tfsdk:"cloud_init"`
+	Sysprep   types.Object `tfsdk:"sysprep"`
+}
+
+var virtualMachineBootstrapAttrTypes = map[string]attr.Type{
+	"cloud_init": types.ObjectType{
+		AttrTypes: virtualMachineSecretKeyAttrTypes,
+	},
+	"sysprep": types.ObjectType{
+		AttrTypes: virtualMachineSecretKeyAttrTypes,
+	},
+}
+
+type virtualMachineSecretKeyModel struct {
+	SecretName types.String `tfsdk:"secret_name"`
+	SecretKey  types.String `tfsdk:"secret_key"`
+}
+
+var virtualMachineSecretKeyAttrTypes = map[string]attr.Type{
+	"secret_name": types.StringType,
+	"secret_key":  types.StringType,
+}
+
+// ── Network interfaces ───────────────────────────────────────────────────────
+
+type virtualMachineNetworkInterfaceModel struct {
+	Name              types.String `tfsdk:"name"`
+	NetworkName       types.String `tfsdk:"network_name"`
+	NetworkKind       types.String `tfsdk:"network_kind"`
+	NetworkAPIVersion types.String `tfsdk:"network_api_version"`
+}
+
+var virtualMachineNetworkInterfaceAttrTypes = map[string]attr.Type{
+	"name":                types.StringType,
+	"network_name":        types.StringType,
+	"network_kind":        types.StringType,
+	"network_api_version": types.StringType,
+}
+
+// ── Status ───────────────────────────────────────────────────────────────────
+
+type virtualMachineStatusModel struct {
+	PowerState   types.String `tfsdk:"power_state"`
+	PrimaryIP4   types.String `tfsdk:"primary_ip4"`
+	PrimaryIP6   types.String `tfsdk:"primary_ip6"`
+	UniqueID     types.String `tfsdk:"unique_id"`
+	BiosUUID     types.String `tfsdk:"bios_uuid"`
+	InstanceUUID types.String `tfsdk:"instance_uuid"`
+	Zone         types.String `tfsdk:"zone"`
+	Conditions   types.Set    `tfsdk:"conditions"`
+}
+
+var virtualMachineStatusAttrTypes = map[string]attr.Type{
+	"power_state":   types.StringType,
+	"primary_ip4":   types.StringType,
+	"primary_ip6":   types.StringType,
+	"unique_id":     types.StringType,
+	"bios_uuid":     types.StringType,
+	"instance_uuid": types.StringType,
+	"zone":          types.StringType,
+	"conditions": types.SetType{
+		ElemType: types.ObjectType{
+			AttrTypes: kubernetes.ConditionAttrTypes,
+		},
+	},
+}
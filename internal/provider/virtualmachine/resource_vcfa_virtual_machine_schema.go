@@ -0,0 +1,326 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (r *vcfaVirtualMachineResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	secretKeyAttrs := func(defaultKey string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"secret_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the Secret, in the same namespace as the virtual machine, that holds the bootstrap data",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kubernetes.ReDNSSubdomain, "must be a valid DNS subdomain"),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultKey),
+				Description: fmt.Sprintf("Key of the Secret that holds the bootstrap data. Defaults to '%s'", defaultKey),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Resource for managing a VM Service %s in a Supervisor Namespace.", vcfatypes.LabelVirtualMachine),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Internal identifier of the %s", vcfatypes.LabelVirtualMachine),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Required attributes
+			"context": common.VcfContextResourceSchema,
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s (must be RFC 1123 DNS subdomain compliant)", vcfatypes.LabelVirtualMachine),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kubernetes.ReDNSSubdomain, "must be a valid DNS subdomain"),
+				},
+			},
+
+			// Wait attributes
+			"wait_for": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Controls whether certain operations block until the virtual machine reaches a certain state",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"powered_on": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "When true, Create and Update operations block until the virtual machine reports the PoweredOn power state. Requires power_state to be PoweredOn.",
+					},
+					"ip_assigned": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "When true, Create and Update operations block until the virtual machine reports a primary IPv4 or IPv6 address. Requires power_state to be PoweredOn.",
+					},
+					"deleted": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "When true, Delete operation blocks until the virtual machine is fully removed. Set to false (default) to return immediately after the delete API call.",
+					},
+				},
+			},
+
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+
+			// Metadata attributes
+			"metadata": kubernetes.MetadataResourceSchema,
+
+			// User-managed virtual machine metadata. Only the keys explicitly set here are
+			// tracked in Terraform state; any additional labels/annotations injected by the
+			// backend are silently ignored and will never appear in the plan diff.
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed labels to set on the virtual machine's ObjectMeta. Keys not present here are not tracked, so backend-injected labels are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed annotations to set on the virtual machine's ObjectMeta. Keys not present here are not tracked, so backend-injected annotations are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Spec attributes
+			"class_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the VirtualMachineClass that describes the virtual hardware of the virtual machine. It must be available in the Supervisor Namespace (see `vm_classes` of `vcfa_supervisor_namespace`)",
+			},
+			"image": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the image used to deploy the virtual machine, such as the `image_identifier` of a `vcfa_content_library_item` (e.g. vmi-0123456789abcdef0)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_kind": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(vcfatypes.VirtualMachineImageKind),
+				Description: fmt.Sprintf("Kind of the image: '%s' (default) for images of the namespace content libraries, or '%s' for images shared with every namespace", vcfatypes.VirtualMachineImageKind, vcfatypes.ClusterVirtualMachineImageKind),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(vcfatypes.VirtualMachineImageKind, vcfatypes.ClusterVirtualMachineImageKind),
+				},
+			},
+			"storage_class": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the StorageClass where the virtual machine disks are placed",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"power_state": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(vcfatypes.VirtualMachinePowerStateOn),
+				Description: fmt.Sprintf("Desired power state of the virtual machine: '%s' (default), '%s' or '%s'", vcfatypes.VirtualMachinePowerStateOn, vcfatypes.VirtualMachinePowerStateOff, vcfatypes.VirtualMachinePowerStateSuspended),
+				Validators: []validator.String{
+					stringvalidator.OneOf(vcfatypes.VirtualMachinePowerStateOn, vcfatypes.VirtualMachinePowerStateOff, vcfatypes.VirtualMachinePowerStateSuspended),
+				},
+			},
+			"bootstrap": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Guest customization applied when the virtual machine is first powered on. Exactly one of `cloud_init` or `sysprep` must be set",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"cloud_init": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Cloud-init user data read from a Secret",
+						Attributes:  secretKeyAttrs(vcfatypes.VirtualMachineCloudInitDefaultKey),
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("sysprep")),
+						},
+					},
+					"sysprep": schema.SingleNestedAttribute{
+						Optional:    true,
+						Description: "Sysprep unattend XML read from a Secret, for Windows guests",
+						Attributes:  secretKeyAttrs(vcfatypes.VirtualMachineSysprepDefaultKey),
+					},
+				},
+			},
+			"network_interfaces": schema.ListNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Network interfaces of the virtual machine. When omitted, the backend attaches a single interface to the default network of the namespace",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+					listplanmodifier.RequiresReplaceIf(networkInterfacesRequireReplace,
+						"Changing the configured network interfaces requires replacement",
+						"Changing the configured network interfaces requires replacement"),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the interface inside the guest (e.g. eth0)",
+						},
+						"network_name": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Name of the network the interface is attached to. The default network of the namespace is used when omitted",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"network_kind": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Kind of the network the interface is attached to (e.g. SubnetSet, Subnet)",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(kubernetes.ReK8sKind, "must be a valid Kubernetes kind"),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("network_name")),
+							},
+						},
+						"network_api_version": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "API version of the network the interface is attached to (e.g. crd.nsx.vmware.com/v1alpha1)",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(kubernetes.ReAPIVersion, "must be a valid API version (group/version)"),
+								stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("network_kind")),
+							},
+						},
+					},
+				},
+			},
+
+			// Status attributes
+			"status": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Observed state of the %s", vcfatypes.LabelVirtualMachine),
+				Attributes: map[string]schema.Attribute{
+					"power_state": schema.StringAttribute{
+						Computed:    true,
+						Description: "Observed power state of the virtual machine",
+					},
+					"primary_ip4": schema.StringAttribute{
+						Computed:    true,
+						Description: "Primary IPv4 address assigned to the guest",
+					},
+					"primary_ip6": schema.StringAttribute{
+						Computed:    true,
+						Description: "Primary IPv6 address assigned to the guest",
+					},
+					"unique_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Managed object identifier of the virtual machine in vSphere",
+					},
+					"bios_uuid": schema.StringAttribute{
+						Computed:    true,
+						Description: "BIOS UUID of the virtual machine",
+					},
+					"instance_uuid": schema.StringAttribute{
+						Computed:    true,
+						Description: "Instance UUID of the virtual machine",
+					},
+					"zone": schema.StringAttribute{
+						Computed:    true,
+						Description: "Zone where the virtual machine is placed",
+					},
+					"conditions": kubernetes.ConditionsResourceSchema,
+				},
+			},
+		},
+	}
+}
+
+// networkInterfacesRequireReplace replaces the virtual machine when the configured network
+// interfaces change. The network of the interfaces that do not configure one is resolved by
+// the backend, so these values are not compared.
+func networkInterfacesRequireReplace(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.ConfigValue.IsNull() || req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+		return
+	}
+	if req.ConfigValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	var configured, current []virtualMachineNetworkInterfaceModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &configured, false)...)
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(configured) != len(current) {
+		resp.RequiresReplace = true
+		return
+	}
+
+	changed := func(config, state types.String) bool {
+		return !config.IsNull() && !config.Equal(state)
+	}
+	for i := range configured {
+		if changed(configured[i].Name, current[i].Name) ||
+			changed(configured[i].NetworkName, current[i].NetworkName) ||
+			changed(configured[i].NetworkKind, current[i].NetworkKind) ||
+			changed(configured[i].NetworkAPIVersion, current[i].NetworkAPIVersion) {
+			resp.RequiresReplace = true
+			return
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// virtualMachineWaitCriteria holds everything that Create and Update wait for
+// after the API call, as configured in the wait_for attribute.
+type virtualMachineWaitCriteria struct {
+	poweredOn  bool
+	ipAssigned bool
+}
+
+func (c virtualMachineWaitCriteria) isEmpty() bool {
+	return !c.poweredOn && !c.ipAssigned
+}
+
+func extractWaitForCriteria(ctx context.Context, waitForObj types.Object) (virtualMachineWaitCriteria, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var criteria virtualMachineWaitCriteria
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return criteria, diags
+	}
+	var wf virtualMachineWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	criteria.poweredOn = wf.PoweredOn.ValueBool()
+	criteria.ipAssigned = wf.IPAssigned.ValueBool()
+	return criteria, diags
+}
+
+func extractWaitForDeleted(ctx context.Context, waitForObj types.Object) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return false, diags
+	}
+	var wf virtualMachineWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	return wf.Deleted.ValueBool(), diags
+}
+
+// waitForVirtualMachineReady polls the virtual machine until every criterion is met and
+// returns its latest observed state. On timeout, the pending criteria are added to diags
+// as warnings to help troubleshooting.
+func waitForVirtualMachineReady(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, timeout time.Duration, criteria virtualMachineWaitCriteria, diags *diag.Diagnostics) (*vcfatypes.VirtualMachine, error) {
+	const (
+		virtualMachineStateReady    = "Ready"
+		virtualMachineStateNotReady = "NotReady"
+	)
+
+	var lastPending []string
+	conf := &retry.StateChangeConf{
+		Pending:      []string{virtualMachineStateNotReady},
+		Target:       []string{virtualMachineStateReady},
+		Timeout:      timeout,
+		PollInterval: virtualMachinePollInterval,
+		Refresh: func() (any, string, error) {
+			var vm vcfatypes.VirtualMachine
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineGVR(), &vm); err != nil {
+				if apierrors.IsNotFound(err) {
+					return nil, "", fmt.Errorf("%s %s in VCF context %s/%s not found while waiting to become ready", vcfatypes.LabelVirtualMachine, name, projectName, namespace)
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to become ready: %w", vcfatypes.LabelVirtualMachine, name, projectName, namespace, err)
+			}
+
+			pending := pendingVirtualMachineCriteria(&vm, criteria)
+			lastPending = pending
+			if len(pending) == 0 {
+				return &vm, virtualMachineStateReady, nil
+			}
+			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to become ready: %s", vcfatypes.LabelVirtualMachine, name, projectName, namespace, strings.Join(pending, "; "))
+			return &vm, virtualMachineStateNotReady, nil
+		},
+	}
+
+	result, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			for _, p := range lastPending {
+				diags.AddWarning(fmt.Sprintf("%s %s is not ready yet", vcfatypes.LabelVirtualMachine, name), p)
+			}
+		}
+		return nil, fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be ready: %w", vcfatypes.LabelVirtualMachine, name, projectName, namespace, err)
+	}
+	return result.(*vcfatypes.VirtualMachine), nil
+}
+
+// pendingVirtualMachineCriteria returns a description of every criterion that is not met yet.
+func pendingVirtualMachineCriteria(vm *vcfatypes.VirtualMachine, criteria virtualMachineWaitCriteria) []string {
+	var pending []string
+	if criteria.poweredOn && vm.Status.PowerState != vcfatypes.VirtualMachinePowerStateOn {
+		pending = append(pending, fmt.Sprintf("power state is %q (expected %s)", vm.Status.PowerState, vcfatypes.VirtualMachinePowerStateOn))
+	}
+	if criteria.ipAssigned && (vm.Status.Network == nil || (vm.Status.Network.PrimaryIP4 == "" && vm.Status.Network.PrimaryIP6 == "")) {
+		pending = append(pending, "no primary IP address is assigned yet")
+	}
+	return pending
+}
+
+func waitForVirtualMachineDeleted(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, deleteTimeout time.Duration) error {
+	const (
+		virtualMachineStateExists  = "Exists"
+		virtualMachineStateDeleted = "Deleted"
+	)
+
+	conf := &retry.StateChangeConf{
+		Pending:      []string{virtualMachineStateExists},
+		Target:       []string{virtualMachineStateDeleted},
+		Timeout:      deleteTimeout,
+		PollInterval: virtualMachinePollInterval,
+		Refresh: func() (any, string, error) {
+			var vm vcfatypes.VirtualMachine
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineGVR(), &vm); err != nil {
+				if apierrors.IsNotFound(err) {
+					return "", virtualMachineStateDeleted, nil
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to be deleted: %w", vcfatypes.LabelVirtualMachine, name, projectName, namespace, err)
+			}
+			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to be deleted (deletionTimestamp: %s - finalizers: %s)", vcfatypes.LabelVirtualMachine, name, projectName, namespace, vm.DeletionTimestamp, vm.Finalizers)
+			return &vm, virtualMachineStateExists, nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be deleted: %w", vcfatypes.LabelVirtualMachine, name, projectName, namespace, err)
+	}
+	return nil
+}
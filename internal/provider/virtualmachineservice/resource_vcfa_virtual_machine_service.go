@@ -0,0 +1,461 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachineservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+const (
+	virtualMachineServiceCreateDefaultTimeout  = 10 * time.Minute
+	virtualMachineServiceUpdateDefaultTimeout  = 10 * time.Minute
+	virtualMachineServiceDeleteDefaultTimeout  = 5 * time.Minute
+	virtualMachineServicePollInterval          = 5 * time.Second
+	virtualMachineServiceConflictMaxRetries    = 5
+	virtualMachineServiceConflictRetryInterval = 2 * time.Second
+)
+
+var (
+	_ resource.Resource                = (*vcfaVirtualMachineServiceResource)(nil)
+	_ resource.ResourceWithConfigure   = (*vcfaVirtualMachineServiceResource)(nil)
+	_ resource.ResourceWithImportState = (*vcfaVirtualMachineServiceResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*vcfaVirtualMachineServiceResource)(nil)
+)
+
+type vcfaVirtualMachineServiceResource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaVirtualMachineServiceResource() resource.Resource {
+	return &vcfaVirtualMachineServiceResource{}
+}
+
+func (r *vcfaVirtualMachineServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine_service"
+}
+
+func (r *vcfaVirtualMachineServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error retrieving TM client from provider data", err.Error())
+		return
+	}
+	r.tmClient = tmClient
+}
+
+func (r *vcfaVirtualMachineServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vcfaVirtualMachineServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, virtualMachineServiceCreateDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitExternalIP, diags := extractWaitForExternalIP(ctx, plan.WaitFor, plan.Type)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	svcObj := mapResourceModelToVirtualMachineService(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created vcfatypes.VirtualMachineService
+	if err := k8sClient.CreateNamespaceScopedResource(ctx, vcfatypes.GetVirtualMachineServiceGVR(), namespace, svcObj, &created, false); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error creating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not create %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	latest := &created
+	if waitExternalIP {
+		ready, err := waitForVirtualMachineServiceExternalIP(ctx, k8sClient, project, namespace, name, createTimeout, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s created but has no external IP yet", vcfatypes.LabelVirtualMachineService, name),
+				fmt.Sprintf("%s %s in VCF context %s/%s was created but did not get an external IP from its load balancer: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+			)
+		} else {
+			latest = ready
+		}
+	}
+
+	mapVirtualMachineServiceToResourceModel(ctx, latest, &plan, &resp.Diagnostics)
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaVirtualMachineServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vcfaVirtualMachineServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	var svc vcfatypes.VirtualMachineService
+	if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineServiceGVR(), &svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	priorExternalIP := state.ExternalIP
+	mapVirtualMachineServiceToResourceModel(ctx, &svc, &state, &resp.Diagnostics)
+
+	// The ingress status is refreshed on every read. Point out when the load balancer
+	// address changed, as clients using the previous address are no longer served.
+	if !priorExternalIP.IsNull() && !priorExternalIP.IsUnknown() && !priorExternalIP.Equal(state.ExternalIP) {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("external IP of %s %s changed", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("the load balancer of %s %s in VCF context %s/%s now reports %q instead of %q", vcfatypes.LabelVirtualMachineService, name, project, namespace, state.ExternalIP.ValueString(), priorExternalIP.ValueString()),
+		)
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	// Keep only the user-managed subset of labels/annotations so that
+	// backend-injected entries never appear as diffs in the plan.
+	state.Labels = kubernetes.FilterToUserManagedKeys(ctx, svc.Labels, state.Labels, &resp.Diagnostics)
+	state.Annotations = kubernetes.FilterToUserManagedKeys(ctx, svc.Annotations, state.Annotations, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vcfaVirtualMachineServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state vcfaVirtualMachineServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan vcfaVirtualMachineServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, plan.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, virtualMachineServiceUpdateDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitExternalIP, diags := extractWaitForExternalIP(ctx, plan.WaitFor, plan.Type)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := plan.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	patchBytes, diags := createMergePatch(ctx, state, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var latest vcfatypes.VirtualMachineService
+	// Only send the patch request if there are changes to apply.
+	if len(patchBytes) > 2 {
+		if err := k8sClient.MergePatchNamespaceScopedResource(ctx, vcfatypes.GetVirtualMachineServiceGVR(), namespace, name, patchBytes, &latest, virtualMachineServiceConflictMaxRetries, virtualMachineServiceConflictRetryInterval); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachineService, name),
+				fmt.Sprintf("could not patch %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+			)
+			return
+		}
+	} else if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineServiceGVR(), &latest); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not read %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	if waitExternalIP {
+		ready, err := waitForVirtualMachineServiceExternalIP(ctx, k8sClient, project, namespace, name, updateTimeout, &resp.Diagnostics)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s updated but has no external IP yet", vcfatypes.LabelVirtualMachineService, name),
+				fmt.Sprintf("%s %s in VCF context %s/%s was updated but did not get an external IP from its load balancer: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+			)
+		} else {
+			latest = *ready
+		}
+	}
+
+	mapVirtualMachineServiceToResourceModel(ctx, &latest, &plan, &resp.Diagnostics)
+
+	// The metadata changes with every update (e.g. resource_version); keep the planned
+	// value to prevent an inconsistent result. The next Read refreshes it.
+	plan.Metadata = state.Metadata
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
+
+	helpers.SanitizeUnknownForState(ctx, reflect.ValueOf(&plan).Elem())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vcfaVirtualMachineServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vcfaVirtualMachineServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, state.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, virtualMachineServiceDeleteDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitDeleted, diags := extractWaitForDeleted(ctx, state.WaitFor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	name := state.Name.ValueString()
+
+	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
+
+	if err := k8sClient.DeleteNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineServiceGVR(), false); err != nil {
+		if apierrors.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not delete %s %s in VCF context %s/%s: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	if waitDeleted {
+		if err := waitForVirtualMachineServiceDeleted(ctx, k8sClient, project, namespace, name, deleteTimeout); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%s %s deletion still in progress", vcfatypes.LabelVirtualMachineService, name),
+				fmt.Sprintf("%s %s deletion in VCF context %s/%s was initiated but did not complete within the timeout: %s", vcfatypes.LabelVirtualMachineService, name, project, namespace, err.Error()),
+			)
+		}
+	}
+}
+
+func (r *vcfaVirtualMachineServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, vcfa.ImportSeparator, 4)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"invalid import ID format",
+			fmt.Sprintf("expected project%snamespace%sname, got: %s", vcfa.ImportSeparator, vcfa.ImportSeparator, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context").AtName("namespace"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+// ModifyPlan marks external_ip as unknown when the type or the ports of the service change,
+// as the load balancer may then report a different address. It also plans an update when a
+// LoadBalancer service lost its external IP outside of Terraform, e.g. after its load balancer
+// was recreated, so that apply waits for the load balancer to report an ingress address again.
+func (r *vcfaVirtualMachineServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan vcfaVirtualMachineServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Type.Equal(state.Type) || !plan.Ports.Equal(state.Ports) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("external_ip"), types.StringUnknown())...)
+		return
+	}
+
+	if !state.ExternalIP.IsNull() || plan.ExternalIP.IsUnknown() {
+		return
+	}
+	waitExternalIP, diags := extractWaitForExternalIP(ctx, plan.WaitFor, plan.Type)
+	resp.Diagnostics.Append(diags...)
+	if !waitExternalIP {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("%s %s has no external IP", vcfatypes.LabelVirtualMachineService, plan.Name.ValueString()),
+		"The load balancer of the service does not report any ingress address. Apply will wait for it, as requested in wait_for.external_ip",
+	)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("external_ip"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.ObjectUnknown(virtualMachineServiceStatusAttrTypes))...)
+}
+
+func createMergePatch(ctx context.Context, state vcfaVirtualMachineServiceResourceModel, plan vcfaVirtualMachineServiceResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	name := plan.Name.ValueString()
+
+	oldObj := mapResourceModelToVirtualMachineService(ctx, &state, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+	oldJSON, err := json.Marshal(oldObj)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not marshal old state to JSON: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	newObj := mapResourceModelToVirtualMachineService(ctx, &plan, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+	newJSON, err := json.Marshal(newObj)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not marshal new plan to JSON: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	// Compute the JSON Merge Patch (RFC 7396) from the diff between old and new, so
+	// that fields defaulted by the backend are never overwritten.
+	patchBytes, err := jsonpatch.CreateMergePatch(oldJSON, newJSON)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not compute merge patch: %s", err.Error()),
+		)
+		return patchBytes, diags
+	}
+
+	// Express label and annotation changes as per-key diffs so that removing the
+	// last user-managed key does not erase the backend-injected ones.
+	patchBytes, err = kubernetes.InjectPerKeyMapDiffs(ctx, patchBytes, state.Labels, plan.Labels, state.Annotations, plan.Annotations, &diags)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVirtualMachineService, name),
+			fmt.Sprintf("could not inject per-key label/annotation diffs: %s", err.Error()),
+		)
+	}
+	return patchBytes, diags
+}
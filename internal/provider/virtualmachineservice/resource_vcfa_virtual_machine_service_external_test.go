@@ -0,0 +1,148 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachineservice_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+// TestAccVcfaVirtualMachineServiceResourceExternal exercises the full lifecycle
+// (create → update → import → destroy) of the vcfa_virtual_machine_service resource,
+// exposing a vcfa_virtual_machine through a load balancer, against a live environment.
+func TestAccVcfaVirtualMachineServiceResourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	// Kubernetes resource names must be lowercase DNS labels.
+	name := strings.ReplaceAll(strings.ToLower(t.Name()), "_", "-")
+
+	params := testutils.StringMap{
+		"Project":      cfg.VmService.Project,
+		"Namespace":    cfg.VmService.Namespace,
+		"Name":         name,
+		"VmClass":      cfg.VmService.VmClass,
+		"StorageClass": cfg.VmService.StorageClass,
+		"Image":        cfg.VmService.Image,
+		"SourceRange":  "0.0.0.0/0",
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText1 := testutils.TemplateFill(t, testAccVcfaVirtualMachineServiceExternalConfig, params)
+	params["FuncName"] = t.Name() + "-update"
+	params["SourceRange"] = "10.0.0.0/8"
+	configText2 := testutils.TemplateFill(t, testAccVcfaVirtualMachineServiceExternalConfig, params)
+
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Step 1: create the virtual machine and expose it through a load balancer.
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine_service.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "name", name),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "type", "LoadBalancer"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "ports.#", "1"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "ports.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "ports.0.port", "80"),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "selector.app", name),
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "load_balancer_source_ranges.#", "1"),
+					resource.TestCheckTypeSetElemAttr("vcfa_virtual_machine_service.test", "load_balancer_source_ranges.*", "0.0.0.0/0"),
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine_service.test", "external_ip"),
+					resource.TestCheckResourceAttrPair("vcfa_virtual_machine_service.test", "external_ip", "vcfa_virtual_machine_service.test", "status.ingress.0.ip"),
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine_service.test", "metadata.uid"),
+				),
+			},
+			// Step 2: restrict the source ranges of the load balancer.
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_virtual_machine_service.test", "load_balancer_source_ranges.#", "1"),
+					resource.TestCheckTypeSetElemAttr("vcfa_virtual_machine_service.test", "load_balancer_source_ranges.*", "10.0.0.0/8"),
+					resource.TestCheckResourceAttrSet("vcfa_virtual_machine_service.test", "external_ip"),
+				),
+			},
+			// Step 3: import and verify the state round-trips cleanly.
+			{
+				ResourceName:      "vcfa_virtual_machine_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["Project"].(string) + vcfa.ImportSeparator + params["Namespace"].(string) + vcfa.ImportSeparator + params["Name"].(string), nil
+				},
+				ImportStateVerifyIgnore: []string{
+					"wait_for",    // local-only
+					"timeouts",    // local-only
+					"labels",      // user-managed subset, unknown on import
+					"annotations", // user-managed subset, unknown on import
+					"metadata",    // computed-only
+					"status",      // computed-only
+				},
+			},
+		},
+	})
+}
+
+// testAccVcfaVirtualMachineServiceExternalConfig is the HCL template of every step.
+const testAccVcfaVirtualMachineServiceExternalConfig = `
+resource "vcfa_virtual_machine" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  name          = "{{.Name}}"
+  class_name    = "{{.VmClass}}"
+  image         = "{{.Image}}"
+  storage_class = "{{.StorageClass}}"
+
+  labels = {
+    app = "{{.Name}}"
+  }
+
+  wait_for = {
+    deleted = true
+  }
+}
+
+resource "vcfa_virtual_machine_service" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  name     = "{{.Name}}"
+  selector = vcfa_virtual_machine.test.labels
+
+  ports = [
+    {
+      name        = "http"
+      port        = 80
+      target_port = 80
+    },
+  ]
+
+  load_balancer_source_ranges = ["{{.SourceRange}}"]
+
+  wait_for = {
+    external_ip = true
+    deleted     = true
+  }
+}
+`
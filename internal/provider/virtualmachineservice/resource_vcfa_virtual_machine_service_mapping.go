@@ -0,0 +1,145 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachineservice
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// ── API → Terraform state ────────────────────────────────────────────────────
+
+func mapVirtualMachineServiceToResourceModel(ctx context.Context, svc *vcfatypes.VirtualMachineService, model *vcfaVirtualMachineServiceResourceModel, diags *diag.Diagnostics) {
+	model.Metadata = helpers.ObjFrom(ctx, kubernetes.MetadataAttrTypes,
+		kubernetes.MapMetadataToModel(ctx, svc.ObjectMeta, diags), diags)
+
+	svcType := svc.Spec.Type
+	if svcType == "" {
+		svcType = vcfatypes.VirtualMachineServiceTypeLoadBalancer
+	}
+	model.Type = types.StringValue(svcType)
+
+	ports := make([]virtualMachineServicePortModel, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		ports = append(ports, virtualMachineServicePortModel{
+			Name:       types.StringValue(p.Name),
+			Protocol:   types.StringValue(p.Protocol),
+			Port:       types.Int32Value(p.Port),
+			TargetPort: types.Int32Value(p.TargetPort),
+		})
+	}
+	portList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: virtualMachineServicePortAttrTypes}, ports)
+	diags.Append(d...)
+	model.Ports = portList
+
+	selector, d := types.MapValueFrom(ctx, types.StringType, svc.Spec.Selector)
+	diags.Append(d...)
+	model.Selector = selector
+
+	if len(svc.Spec.LoadBalancerSourceRanges) == 0 {
+		model.LoadBalancerSourceRanges = types.SetNull(cidrtypes.IPPrefixType{})
+	} else {
+		prefixes := make([]cidrtypes.IPPrefix, 0, len(svc.Spec.LoadBalancerSourceRanges))
+		for _, r := range svc.Spec.LoadBalancerSourceRanges {
+			prefixes = append(prefixes, cidrtypes.NewIPPrefixValue(r))
+		}
+		ranges, d := types.SetValueFrom(ctx, cidrtypes.IPPrefixType{}, prefixes)
+		diags.Append(d...)
+		model.LoadBalancerSourceRanges = ranges
+	}
+
+	model.ExternalIP = helpers.StringOrNull(externalIPOf(svc))
+	model.Status = mapVirtualMachineServiceStatusToModel(ctx, svc, diags)
+}
+
+func mapVirtualMachineServiceStatusToModel(ctx context.Context, svc *vcfatypes.VirtualMachineService, diags *diag.Diagnostics) types.Object {
+	ingress := make([]virtualMachineServiceIngressModel, 0, len(svc.Status.LoadBalancer.Ingress))
+	for _, i := range svc.Status.LoadBalancer.Ingress {
+		ingress = append(ingress, virtualMachineServiceIngressModel{
+			IP:       helpers.StringOrNull(i.IP),
+			Hostname: helpers.StringOrNull(i.Hostname),
+		})
+	}
+	ingressList, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: virtualMachineServiceIngressAttrTypes}, ingress)
+	diags.Append(d...)
+
+	status := virtualMachineServiceStatusModel{
+		Ingress: ingressList,
+		Conditions: helpers.SetFrom(ctx,
+			types.ObjectType{AttrTypes: kubernetes.ConditionAttrTypes},
+			kubernetes.MapConditionsToModel(ctx, svc.Status.Conditions, diags),
+			diags),
+	}
+	return helpers.ObjFrom(ctx, virtualMachineServiceStatusAttrTypes, status, diags)
+}
+
+// externalIPOf returns the first ingress IP address reported by the load balancer of the
+// service, or its hostname when the load balancer does not report IP addresses.
+func externalIPOf(svc *vcfatypes.VirtualMachineService) string {
+	for _, i := range svc.Status.LoadBalancer.Ingress {
+		if i.IP != "" {
+			return i.IP
+		}
+		if i.Hostname != "" {
+			return i.Hostname
+		}
+	}
+	return ""
+}
+
+// ── Terraform plan → API ─────────────────────────────────────────────────────
+
+func mapResourceModelToVirtualMachineService(ctx context.Context, model *vcfaVirtualMachineServiceResourceModel, diags *diag.Diagnostics) *vcfatypes.VirtualMachineService {
+	vcfContext := common.ExtractVcfContext(ctx, model.Context, diags)
+
+	svc := &vcfatypes.VirtualMachineService{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: vcfatypes.VirtualMachineAPIVersion,
+			Kind:       vcfatypes.VirtualMachineServiceKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        model.Name.ValueString(),
+			Namespace:   vcfContext.Namespace.ValueString(),
+			Labels:      helpers.ExtractStringMap(ctx, model.Labels, diags),
+			Annotations: helpers.ExtractStringMap(ctx, model.Annotations, diags),
+		},
+		Spec: vcfatypes.VirtualMachineServiceSpec{
+			Type:     model.Type.ValueString(),
+			Selector: helpers.ExtractStringMap(ctx, model.Selector, diags),
+		},
+	}
+
+	if !model.Ports.IsNull() && !model.Ports.IsUnknown() {
+		var ports []virtualMachineServicePortModel
+		diags.Append(model.Ports.ElementsAs(ctx, &ports, false)...)
+		for _, p := range ports {
+			svc.Spec.Ports = append(svc.Spec.Ports, vcfatypes.VirtualMachineServicePort{
+				Name:       p.Name.ValueString(),
+				Protocol:   p.Protocol.ValueString(),
+				Port:       p.Port.ValueInt32(),
+				TargetPort: p.TargetPort.ValueInt32(),
+			})
+		}
+	}
+
+	if !model.LoadBalancerSourceRanges.IsNull() && !model.LoadBalancerSourceRanges.IsUnknown() {
+		var prefixes []cidrtypes.IPPrefix
+		diags.Append(model.LoadBalancerSourceRanges.ElementsAs(ctx, &prefixes, false)...)
+		for _, p := range prefixes {
+			svc.Spec.LoadBalancerSourceRanges = append(svc.Spec.LoadBalancerSourceRanges, p.ValueString())
+		}
+	}
+
+	return svc
+}
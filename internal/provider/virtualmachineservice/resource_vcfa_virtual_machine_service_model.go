@@ -0,0 +1,98 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachineservice
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+)
+
+// ── Resource Top-level model ─────────────────────────────────────────────────
+
+type vcfaVirtualMachineServiceResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Context types.Object `tfsdk:"context"`
+	Name    types.String `tfsdk:"name"`
+
+	// Wait controls
+	WaitFor types.Object `tfsdk:"wait_for"`
+
+	// Timeouts
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	// Metadata
+	Metadata types.Object `tfsdk:"metadata"`
+
+	// User-managed labels and annotations on the service's ObjectMeta.
+	// Only the keys the user specifies are tracked; backend-injected entries are ignored.
+	Labels      types.Map `tfsdk:"labels"`
+	Annotations types.Map `tfsdk:"annotations"`
+
+	// Spec fields
+	Type                     types.String `tfsdk:"type"`
+	Ports                    types.List   `tfsdk:"ports"`
+	Selector                 types.Map    `tfsdk:"selector"`
+	LoadBalancerSourceRanges types.Set    `tfsdk:"load_balancer_source_ranges"`
+
+	// Status
+	ExternalIP types.String `tfsdk:"external_ip"`
+	Status     types.Object `tfsdk:"status"`
+}
+
+// ── Wait controls ────────────────────────────────────────────────────────────
+
+type virtualMachineServiceWaitForModel struct {
+	ExternalIP types.Bool `tfsdk:"external_ip"`
+	Deleted    types.Bool `tfsdk:"deleted"`
+}
+
+// ── Ports ────────────────────────────────────────────────────────────────────
+
+type virtualMachineServicePortModel struct {
+	Name       types.String `tfsdk:"name"`
+	Protocol   types.String `tfsdk:"protocol"`
+	Port       types.Int32  `tfsdk:"port"`
+	TargetPort types.Int32  `tfsdk:"target_port"`
+}
+
+var virtualMachineServicePortAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"protocol":    types.StringType,
+	"port":        types.Int32Type,
+	"target_port": types.Int32Type,
+}
+
+// ── Status ───────────────────────────────────────────────────────────────────
+
+type virtualMachineServiceStatusModel struct {
+	Ingress    types.List `tfsdk:"ingress"`
+	Conditions types.Set  `tfsdk:"conditions"`
+}
+
+var virtualMachineServiceStatusAttrTypes = map[string]attr.Type{
+	"ingress": types.ListType{
+		ElemType: types.ObjectType{
+			AttrTypes: virtualMachineServiceIngressAttrTypes,
+		},
+	},
+	"conditions": types.SetType{
+		ElemType: types.ObjectType{
+			AttrTypes: kubernetes.ConditionAttrTypes,
+		},
+	},
+}
+
+type virtualMachineServiceIngressModel struct {
+	IP       types.String `tfsdk:"ip"`
+	Hostname types.String `tfsdk:"hostname"`
+}
+
+var virtualMachineServiceIngressAttrTypes = map[string]attr.Type{
+	"ip":       types.StringType,
+	"hostname": types.StringType,
+}
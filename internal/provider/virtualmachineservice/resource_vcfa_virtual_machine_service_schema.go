@@ -0,0 +1,217 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachineservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/validators"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (r *vcfaVirtualMachineServiceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Resource for managing a %s, which exposes VM Service virtual machines of a Supervisor Namespace.", vcfatypes.LabelVirtualMachineService),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Internal identifier of the %s", vcfatypes.LabelVirtualMachineService),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Required attributes
+			"context": common.VcfContextResourceSchema,
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s (must be RFC 1123 DNS label compliant)", vcfatypes.LabelVirtualMachineService),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(kubernetes.ReDNSLabel, "must be a valid DNS label"),
+				},
+			},
+
+			// Wait attributes
+			"wait_for": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Controls whether certain operations block until the service reaches a certain state",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"external_ip": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+						Description: fmt.Sprintf("When true (default), Create and Update operations of a %s service block until the load balancer reports an ingress IP address", vcfatypes.VirtualMachineServiceTypeLoadBalancer),
+					},
+					"deleted": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "When true, Delete operation blocks until the service is fully removed. Set to false (default) to return immediately after the delete API call.",
+					},
+				},
+			},
+
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+
+			// Metadata attributes
+			"metadata": kubernetes.MetadataResourceSchema,
+
+			// User-managed service metadata. Only the keys explicitly set here are tracked in
+			// Terraform state; any additional labels/annotations injected by the backend are
+			// silently ignored and will never appear in the plan diff.
+			"labels": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed labels to set on the service's ObjectMeta. Keys not present here are not tracked, so backend-injected labels are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "User-managed annotations to set on the service's ObjectMeta. Keys not present here are not tracked, so backend-injected annotations are never shown as a diff.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Spec attributes
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(vcfatypes.VirtualMachineServiceTypeLoadBalancer),
+				Description: fmt.Sprintf("How the service is exposed: '%s' (default) or '%s'", vcfatypes.VirtualMachineServiceTypeLoadBalancer, vcfatypes.VirtualMachineServiceTypeClusterIP),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(vcfatypes.VirtualMachineServiceTypeLoadBalancer, vcfatypes.VirtualMachineServiceTypeClusterIP),
+				},
+			},
+			"ports": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "Ports exposed by the service",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the port, unique within the service",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(kubernetes.ReDNSLabel, "must be a valid DNS label"),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(string(corev1.ProtocolTCP)),
+							Description: "Protocol of the port: TCP (default), UDP or SCTP",
+							Validators: []validator.String{
+								stringvalidator.OneOf(string(corev1.ProtocolTCP), string(corev1.ProtocolUDP), string(corev1.ProtocolSCTP)),
+							},
+						},
+						"port": schema.Int32Attribute{
+							Required:    true,
+							Description: "Port exposed by the service",
+							Validators: []validator.Int32{
+								int32validator.Between(1, 65535),
+							},
+						},
+						"target_port": schema.Int32Attribute{
+							Required:    true,
+							Description: "Port of the virtual machines the traffic is forwarded to",
+							Validators: []validator.Int32{
+								int32validator.Between(1, 65535),
+							},
+						},
+					},
+				},
+			},
+			"selector": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Labels of the virtual machines the traffic is routed to, such as the `labels` of a `vcfa_virtual_machine`",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"load_balancer_source_ranges": schema.SetAttribute{
+				Optional:    true,
+				ElementType: cidrtypes.IPPrefixType{},
+				Description: "CIDR blocks of the clients allowed to reach the load balancer (e.g. \"203.0.113.0/24\"). All clients are allowed when omitted",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(validators.IsValidCIDR()),
+				},
+			},
+
+			// Status attributes
+			"external_ip": schema.StringAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("First ingress IP address (or hostname) reported by the load balancer. Always empty for '%s' services", vcfatypes.VirtualMachineServiceTypeClusterIP),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Observed state of the %s", vcfatypes.LabelVirtualMachineService),
+				Attributes: map[string]schema.Attribute{
+					"ingress": schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "Ingress points of the load balancer",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"ip": schema.StringAttribute{
+									Computed:    true,
+									Description: "IP address of the ingress point",
+								},
+								"hostname": schema.StringAttribute{
+									Computed:    true,
+									Description: "Hostname of the ingress point",
+								},
+							},
+						},
+					},
+					"conditions": kubernetes.ConditionsResourceSchema,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package virtualmachineservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// extractWaitForExternalIP reports whether Create and Update must wait for the load
+// balancer to report an ingress address. Services other than LoadBalancer never get one,
+// so the setting is ignored for them.
+func extractWaitForExternalIP(ctx context.Context, waitForObj types.Object, svcType types.String) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if svcType.ValueString() != vcfatypes.VirtualMachineServiceTypeLoadBalancer {
+		return false, diags
+	}
+	// wait_for.external_ip defaults to true
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return true, diags
+	}
+	var wf virtualMachineServiceWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	return wf.ExternalIP.IsNull() || wf.ExternalIP.IsUnknown() || wf.ExternalIP.ValueBool(), diags
+}
+
+func extractWaitForDeleted(ctx context.Context, waitForObj types.Object) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return false, diags
+	}
+	var wf virtualMachineServiceWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	return wf.Deleted.ValueBool(), diags
+}
+
+// waitForVirtualMachineServiceExternalIP polls the service until its load balancer reports an
+// ingress address and returns its latest observed state. On timeout, the unmet conditions of
+// the service are added to diags as warnings to help troubleshooting.
+func waitForVirtualMachineServiceExternalIP(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, timeout time.Duration, diags *diag.Diagnostics) (*vcfatypes.VirtualMachineService, error) {
+	const (
+		virtualMachineServiceStateReady   = "Ready"
+		virtualMachineServiceStatePending = "Pending"
+	)
+
+	var last *vcfatypes.VirtualMachineService
+	conf := &retry.StateChangeConf{
+		Pending:      []string{virtualMachineServiceStatePending},
+		Target:       []string{virtualMachineServiceStateReady},
+		Timeout:      timeout,
+		PollInterval: virtualMachineServicePollInterval,
+		Refresh: func() (any, string, error) {
+			var svc vcfatypes.VirtualMachineService
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineServiceGVR(), &svc); err != nil {
+				if apierrors.IsNotFound(err) {
+					return nil, "", fmt.Errorf("%s %s in VCF context %s/%s not found while waiting for an external IP", vcfatypes.LabelVirtualMachineService, name, projectName, namespace)
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting for an external IP: %w", vcfatypes.LabelVirtualMachineService, name, projectName, namespace, err)
+			}
+
+			last = &svc
+			if externalIPOf(&svc) != "" {
+				return &svc, virtualMachineServiceStateReady, nil
+			}
+			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to get an external IP", vcfatypes.LabelVirtualMachineService, name, projectName, namespace)
+			return &svc, virtualMachineServiceStatePending, nil
+		},
+	}
+
+	result, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) && last != nil {
+			for _, c := range last.Status.Conditions {
+				if c.Status != metav1.ConditionTrue {
+					diags.AddWarning(fmt.Sprintf("%s %s has no external IP yet", vcfatypes.LabelVirtualMachineService, name),
+						fmt.Sprintf("condition %s is %s: %s %s", c.Type, c.Status, c.Reason, c.Message))
+				}
+			}
+		}
+		return nil, fmt.Errorf("error waiting for %s %s in VCF context %s/%s to get an external IP: %w", vcfatypes.LabelVirtualMachineService, name, projectName, namespace, err)
+	}
+	return result.(*vcfatypes.VirtualMachineService), nil
+}
+
+func waitForVirtualMachineServiceDeleted(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, deleteTimeout time.Duration) error {
+	const (
+		virtualMachineServiceStateExists  = "Exists"
+		virtualMachineServiceStateDeleted = "Deleted"
+	)
+
+	conf := &retry.StateChangeConf{
+		Pending:      []string{virtualMachineServiceStateExists},
+		Target:       []string{virtualMachineServiceStateDeleted},
+		Timeout:      deleteTimeout,
+		PollInterval: virtualMachineServicePollInterval,
+		Refresh: func() (any, string, error) {
+			var svc vcfatypes.VirtualMachineService
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVirtualMachineServiceGVR(), &svc); err != nil {
+				if apierrors.IsNotFound(err) {
+					return "", virtualMachineServiceStateDeleted, nil
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to be deleted: %w", vcfatypes.LabelVirtualMachineService, name, projectName, namespace, err)
+			}
+			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to be deleted (deletionTimestamp: %s - finalizers: %s)", vcfatypes.LabelVirtualMachineService, name, projectName, namespace, svc.DeletionTimestamp, svc.Finalizers)
+			return &svc, virtualMachineServiceStateExists, nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be deleted: %w", vcfatypes.LabelVirtualMachineService, name, projectName, namespace, err)
+	}
+	return nil
+}
@@ -83,8 +83,16 @@ func (d *vcfaVksClusterDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	machineDeployments, err := listVksClusterMachineDeployments(ctx, kubernetesClient, namespace, name)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("could not read %s of %s %s", vcfatypes.LabelVksMachineDeployments, vcfatypes.LabelVksCluster, name),
+			fmt.Sprintf("status.machine_deployments will be empty: %s", err.Error()),
+		)
+	}
+
 	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
-	mapVksClusterToDataSourceModel(ctx, &cluster, &data, &resp.Diagnostics)
+	mapVksClusterToDataSourceModel(ctx, &cluster, machineDeployments, &data, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
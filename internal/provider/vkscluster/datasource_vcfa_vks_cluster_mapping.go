@@ -14,9 +14,9 @@ import (
 
 // mapVksClusterToDataSourceModel populates a vcfaVksClusterDataSourceModel by delegating
 // all mapping logic to mapVksClusterToResourceModel and copying the shared fields.
-func mapVksClusterToDataSourceModel(ctx context.Context, cluster *vcfatypes.VksCluster, model *vcfaVksClusterDataSourceModel, diags *diag.Diagnostics) {
+func mapVksClusterToDataSourceModel(ctx context.Context, cluster *vcfatypes.VksCluster, machineDeployments []vcfatypes.VksMachineDeployment, model *vcfaVksClusterDataSourceModel, diags *diag.Diagnostics) {
 	rsModel := &vcfaVksClusterResourceModel{}
-	mapVksClusterToResourceModel(ctx, cluster, rsModel, diags)
+	mapVksClusterToResourceModel(ctx, cluster, machineDeployments, rsModel, diags)
 
 	// Metadata attributes
 	model.Metadata = rsModel.Metadata
@@ -650,6 +650,38 @@ func (d *vcfaVksClusterDataSource) Schema(_ context.Context, _ datasource.Schema
 							},
 						},
 					},
+					"machine_deployments": schema.SetNestedAttribute{
+						Computed:    true,
+						Description: "Actual replica counts of each worker MachineDeployment, including the ones scaled by the cluster autoscaler",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Computed:    true,
+									Description: "Name of the machine_deployments topology entry",
+								},
+								"desired_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Desired worker machines, as set by Terraform or by the cluster autoscaler",
+								},
+								"replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines including those being provisioned or deleted",
+								},
+								"up_to_date_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines running the latest spec",
+								},
+								"ready_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines in the Ready state",
+								},
+								"available_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines that have been ready for at least minReadySeconds",
+								},
+							},
+						},
+					},
 					"phase": schema.StringAttribute{
 						Computed:    true,
 						Description: "Current lifecycle phase of the cluster: Pending, Provisioning, Provisioned, Deleting, Failed, or Unknown",
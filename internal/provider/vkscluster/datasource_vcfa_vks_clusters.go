@@ -0,0 +1,90 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vkscluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ datasource.DataSource              = (*vcfaVksClustersDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*vcfaVksClustersDataSource)(nil)
+)
+
+type vcfaVksClustersDataSource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaVksClustersDataSource() datasource.DataSource {
+	return &vcfaVksClustersDataSource{}
+}
+
+func (d *vcfaVksClustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vks_clusters"
+}
+
+func (d *vcfaVksClustersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting TM client", err.Error())
+		return
+	}
+	d.tmClient = tmClient
+}
+
+func (d *vcfaVksClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vcfaVksClustersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, data.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	labelSelector := data.LabelSelector.ValueString()
+
+	kubernetesClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s", vcfatypes.LabelVksClusters),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(kubernetesClient.FlushWarnings()...) }()
+
+	var clusters vcfatypes.VksClusterList
+	if err := kubernetesClient.ListNamespaceScopedResources(ctx, namespace, vcfatypes.GetVksClusterGVR(), labelSelector, &clusters); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s", vcfatypes.LabelVksClusters),
+			fmt.Sprintf("could not list %s in VCF context %s/%s: %s", vcfatypes.LabelVksClusters, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", project, namespace))
+	data.Clusters = mapVksClusterListToSummaryModels(ctx, clusters.Items, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,78 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vkscluster
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// mapVksClusterListToSummaryModels converts the items of a Cluster list into the
+// summary entries exposed by the vcfa_vks_clusters data source.
+func mapVksClusterListToSummaryModels(ctx context.Context, clusters []vcfatypes.VksCluster, diags *diag.Diagnostics) []vksClusterSummaryModel {
+	summaries := make([]vksClusterSummaryModel, 0, len(clusters))
+	for i := range clusters {
+		summaries = append(summaries, mapVksClusterToSummaryModel(ctx, &clusters[i], diags))
+	}
+	return summaries
+}
+
+func mapVksClusterToSummaryModel(ctx context.Context, cluster *vcfatypes.VksCluster, diags *diag.Diagnostics) vksClusterSummaryModel {
+	labels := cluster.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelMap, d := types.MapValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+
+	topology := cluster.Spec.Topology
+	workerPools := make([]vksClusterWorkerPoolSummaryModel, 0, len(topology.Workers.MachineDeployments))
+	for _, md := range topology.Workers.MachineDeployments {
+		failureDomain := types.StringNull()
+		if md.FailureDomain != "" {
+			failureDomain = types.StringValue(md.FailureDomain)
+		}
+		workerPools = append(workerPools, vksClusterWorkerPoolSummaryModel{
+			Name:              types.StringValue(md.Name),
+			Class:             types.StringValue(md.Class),
+			FailureDomain:     failureDomain,
+			Replicas:          types.Int32PointerValue(md.Replicas),
+			AutoscalerMinSize: parseAutoscalerAnnotation(md.Metadata.Annotations, vcfatypes.AutoscalerMinSizeAnnotationKey),
+			AutoscalerMaxSize: parseAutoscalerAnnotation(md.Metadata.Annotations, vcfatypes.AutoscalerMaxSizeAnnotationKey),
+		})
+	}
+
+	return vksClusterSummaryModel{
+		Name:                  types.StringValue(cluster.Name),
+		Labels:                labelMap,
+		ClusterClass:          types.StringValue(topology.ClassRef.Name),
+		ClusterClassNamespace: types.StringValue(topology.ClassRef.Namespace),
+		Version:               types.StringValue(topology.Version),
+		ControlPlaneReplicas:  types.Int32PointerValue(topology.ControlPlane.Replicas),
+		WorkerPools:           workerPools,
+		Phase:                 types.StringValue(cluster.Status.Phase),
+		Available:             kubernetes.MapConditionToModel(cluster.Status.Conditions, vcfatypes.VksConditionAvailable),
+	}
+}
+
+// parseAutoscalerAnnotation returns the integer value of the given autoscaler
+// annotation, or null when the annotation is absent or not a valid number.
+func parseAutoscalerAnnotation(annotations map[string]string, key string) types.Int32 {
+	raw, ok := annotations[key]
+	if !ok {
+		return types.Int32Null()
+	}
+	n, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return types.Int32Null()
+	}
+	return types.Int32Value(int32(n))
+}
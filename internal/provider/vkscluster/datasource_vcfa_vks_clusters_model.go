@@ -0,0 +1,44 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vkscluster
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+)
+
+// ── DataSource Top-level model ───────────────────────────────────────────────
+
+type vcfaVksClustersDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Context       types.Object `tfsdk:"context"`
+	LabelSelector types.String `tfsdk:"label_selector"`
+
+	Clusters []vksClusterSummaryModel `tfsdk:"clusters"`
+}
+
+// ── Cluster summary ──────────────────────────────────────────────────────────
+
+type vksClusterSummaryModel struct {
+	Name                  types.String                       `tfsdk:"name"`
+	Labels                types.Map                          `tfsdk:"labels"`
+	ClusterClass          types.String                       `tfsdk:"cluster_class"`
+	ClusterClassNamespace types.String                       `tfsdk:"cluster_class_namespace"`
+	Version               types.String                       `tfsdk:"version"`
+	ControlPlaneReplicas  types.Int32                        `tfsdk:"control_plane_replicas"`
+	WorkerPools           []vksClusterWorkerPoolSummaryModel `tfsdk:"worker_pools"`
+	Phase                 types.String                       `tfsdk:"phase"`
+	Available             *kubernetes.ConditionModel         `tfsdk:"available"`
+}
+
+type vksClusterWorkerPoolSummaryModel struct {
+	Name              types.String `tfsdk:"name"`
+	Class             types.String `tfsdk:"class"`
+	FailureDomain     types.String `tfsdk:"failure_domain"`
+	Replicas          types.Int32  `tfsdk:"replicas"`
+	AutoscalerMinSize types.Int32  `tfsdk:"autoscaler_min_size"`
+	AutoscalerMaxSize types.Int32  `tfsdk:"autoscaler_max_size"`
+}
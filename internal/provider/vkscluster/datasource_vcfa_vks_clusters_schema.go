@@ -0,0 +1,113 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vkscluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/validators"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (d *vcfaVksClustersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Data source for listing the %s of a VCF Automation context", vcfatypes.LabelVksClusters),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal identifier of the listing",
+			},
+
+			// Lookup attributes
+			"context": common.VcfContextDataSourceSchema,
+			"label_selector": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Kubernetes label selector used to filter the %s (e.g. `env=prod,tier in (web,api)`). All the %s of the namespace are returned when omitted", vcfatypes.LabelVksClusters, vcfatypes.LabelVksClusters),
+				Validators: []validator.String{
+					validators.IsValidLabelSelector(),
+				},
+			},
+
+			"clusters": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Summary of every %s matching the lookup", vcfatypes.LabelVksCluster),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s", vcfatypes.LabelVksCluster),
+						},
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: fmt.Sprintf("Labels of the %s", vcfatypes.LabelVksCluster),
+						},
+						"cluster_class": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the ClusterClass used by the cluster",
+						},
+						"cluster_class_namespace": schema.StringAttribute{
+							Computed:    true,
+							Description: "Namespace of the ClusterClass used by the cluster",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Desired Kubernetes Release version of the cluster",
+						},
+						"control_plane_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Desired number of control plane machines",
+						},
+						"worker_pools": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "MachineDeployment topology entries of the cluster",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the MachineDeployment topology entry",
+									},
+									"class": schema.StringAttribute{
+										Computed:    true,
+										Description: "MachineDeployment class defined in the ClusterClass",
+									},
+									"failure_domain": schema.StringAttribute{
+										Computed:    true,
+										Description: "Failure domain where the machines are placed",
+									},
+									"replicas": schema.Int32Attribute{
+										Computed:    true,
+										Description: "Desired number of worker machines; null when the pool is managed by the cluster autoscaler",
+									},
+									"autoscaler_min_size": schema.Int32Attribute{
+										Computed:    true,
+										Description: "Minimum number of worker machines allowed by the cluster autoscaler",
+									},
+									"autoscaler_max_size": schema.Int32Attribute{
+										Computed:    true,
+										Description: "Maximum number of worker machines allowed by the cluster autoscaler",
+									},
+								},
+							},
+						},
+						"phase": schema.StringAttribute{
+							Computed:    true,
+							Description: "Current lifecycle phase of the cluster",
+						},
+						"available": kubernetes.ConditionDataSourceSchema,
+					},
+				},
+			},
+		},
+	}
+}
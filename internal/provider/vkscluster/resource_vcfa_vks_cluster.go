@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	jsonpatch "gopkg.in/evanphx/json-patch.v4"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -37,6 +36,9 @@ const (
 	vksClusterPollInterval          = 5 * time.Second
 	vksClusterConflictMaxRetries    = 5
 	vksClusterConflictRetryInterval = 2 * time.Second
+
+	vksClusterDeletionPolicyDelete = "delete"
+	vksClusterDeletionPolicyOrphan = "orphan"
 )
 
 var (
@@ -90,7 +92,7 @@ func (r *vcfaVksClusterResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	waitForAvailable, diags := r.extractWaitForAvailable(ctx, plan.WaitFor)
+	waitCriteria, diags := r.extractWaitForCriteria(ctx, plan.WaitFor, false)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -126,11 +128,11 @@ func (r *vcfaVksClusterResource) Create(ctx context.Context, req resource.Create
 
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
 
-	if waitForAvailable {
-		if err := r.waitForClusterAvailable(ctx, k8sClient, project, namespace, name, createTimeout); err != nil {
+	if !waitCriteria.isEmpty() {
+		if err := r.waitForClusterReady(ctx, k8sClient, project, namespace, name, createTimeout, waitCriteria, &resp.Diagnostics); err != nil {
 			resp.Diagnostics.AddError(
-				fmt.Sprintf("%s %s created but not yet available", vcfatypes.LabelVksCluster, name),
-				fmt.Sprintf("%s %s in VCF context %s/%s was created but did not reach available state within the timeout: %s", vcfatypes.LabelVksCluster, name, project, namespace, err.Error()),
+				fmt.Sprintf("%s %s created but not yet ready", vcfatypes.LabelVksCluster, name),
+				fmt.Sprintf("%s %s in VCF context %s/%s was created but did not reach the state requested in wait_for: %s", vcfatypes.LabelVksCluster, name, project, namespace, err.Error()),
 			)
 		}
 	}
@@ -178,19 +180,29 @@ func (r *vcfaVksClusterResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	// The per-pool replica counts are informational only: a failure to list the
+	// MachineDeployments must not prevent the cluster itself from being read.
+	machineDeployments, err := listVksClusterMachineDeployments(ctx, k8sClient, namespace, name)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("could not read %s of %s %s", vcfatypes.LabelVksMachineDeployments, vcfatypes.LabelVksCluster, name),
+			fmt.Sprintf("status.machine_deployments will be empty: %s", err.Error()),
+		)
+	}
+
 	// Capture the user-managed keys before mapVksClusterToResourceModel overwrites
 	// unrelated fields.  After the mapping we filter the live API labels/annotations
 	// down to only the keys the user is tracking.
 	priorLabels := state.Labels
 	priorAnnotations := state.Annotations
 
-	mapVksClusterToResourceModel(ctx, &cluster, &state, &resp.Diagnostics)
+	mapVksClusterToResourceModel(ctx, &cluster, machineDeployments, &state, &resp.Diagnostics)
 	state.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
 
 	// Restore only the user-managed subset of labels/annotations so that
 	// backend-injected entries never appear as diffs in the plan.
-	state.Labels = filterToUserManagedKeys(ctx, cluster.Labels, priorLabels, &resp.Diagnostics)
-	state.Annotations = filterToUserManagedKeys(ctx, cluster.Annotations, priorAnnotations, &resp.Diagnostics)
+	state.Labels = kubernetes.FilterToUserManagedKeys(ctx, cluster.Labels, priorLabels, &resp.Diagnostics)
+	state.Annotations = kubernetes.FilterToUserManagedKeys(ctx, cluster.Annotations, priorAnnotations, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -219,7 +231,7 @@ func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	waitForAvailable, diags := r.extractWaitForAvailable(ctx, plan.WaitFor)
+	waitCriteria, diags := r.extractWaitForCriteria(ctx, plan.WaitFor, true)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -347,7 +359,7 @@ func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.Update
 			}
 		}
 
-		mapVksClusterToResourceModel(ctx, &updatedCluster, &plan, &resp.Diagnostics)
+		mapVksClusterToResourceModel(ctx, &updatedCluster, nil, &plan, &resp.Diagnostics)
 
 		plan.Version = planVersion
 
@@ -382,11 +394,11 @@ func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.Update
 			}
 		}
 
-		if waitForAvailable {
-			if err := r.waitForClusterAvailable(ctx, k8sClient, project, namespace, name, updateTimeout); err != nil {
+		if !waitCriteria.isEmpty() {
+			if err := r.waitForClusterReady(ctx, k8sClient, project, namespace, name, updateTimeout, waitCriteria, &resp.Diagnostics); err != nil {
 				resp.Diagnostics.AddError(
-					fmt.Sprintf("%s %s updated but not yet available", vcfatypes.LabelVksCluster, name),
-					fmt.Sprintf("%s %s in VCF context %s/%s was updated but did not reach available state within the timeout: %s", vcfatypes.LabelVksCluster, name, project, namespace, err.Error()),
+					fmt.Sprintf("%s %s updated but not yet ready", vcfatypes.LabelVksCluster, name),
+					fmt.Sprintf("%s %s in VCF context %s/%s was updated but did not reach the state requested in wait_for: %s", vcfatypes.LabelVksCluster, name, project, namespace, err.Error()),
 				)
 			}
 		}
@@ -410,6 +422,16 @@ func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	// ModifyPlan already rejects destroy plans of protected clusters; this guards
+	// against any code path that reaches Delete without going through a plan.
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("cannot delete %s %s", vcfatypes.LabelVksCluster, state.Name.ValueString()),
+			"deletion_protection is enabled. Set it to false and apply the change before destroying the cluster.",
+		)
+		return
+	}
+
 	deleteTimeout, diags := state.Timeouts.Delete(ctx, vksClusterDeleteDefaultTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -426,6 +448,12 @@ func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.Delete
 	namespace := vcfContext.Namespace.ValueString()
 	name := state.Name.ValueString()
 
+	// Orphaned clusters are only removed from the state, so no Kubernetes client is needed.
+	if state.DeletionPolicy.ValueString() == vksClusterDeletionPolicyOrphan {
+		log.Printf("[INFO] %s %s in VCF context %s/%s has deletion_policy %q: removing it from the state without deleting it", vcfatypes.LabelVksCluster, name, project, namespace, vksClusterDeletionPolicyOrphan)
+		return
+	}
+
 	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -436,6 +464,16 @@ func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.Delete
 	}
 	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
 
+	if state.PreDeleteCheck.ValueBool() {
+		if err := checkVksClusterWorkloadBeforeDelete(ctx, k8sClient, namespace, name); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("refusing to delete %s %s", vcfatypes.LabelVksCluster, name),
+				fmt.Sprintf("pre-delete check of %s %s in VCF context %s/%s failed: %s", vcfatypes.LabelVksCluster, name, project, namespace, err.Error()),
+			)
+			return
+		}
+	}
+
 	if err := k8sClient.DeleteNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVksClusterGVR(), false); err != nil {
 		if apierrors.IsNotFound(err) {
 			return
@@ -498,6 +536,13 @@ func (r *vcfaVksClusterResource) ValidateConfig(ctx context.Context, req resourc
 }
 
 func (r *vcfaVksClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Deletion protection is evaluated first: it needs no API call and must also
+	// apply to destroy plans.
+	r.checkDeletionProtection(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// No API calls are possible when the provider is not yet configured (e.g. terraform validate).
 	if r.tmClient == nil {
 		return
@@ -580,7 +625,7 @@ func (r *vcfaVksClusterResource) ModifyPlan(ctx context.Context, req resource.Mo
 	// what will actually be sent to the API during apply.
 	var liveModel vcfaVksClusterResourceModel
 	var mappingDiags diag.Diagnostics
-	mapVksClusterToResourceModel(ctx, &currentCluster, &liveModel, &mappingDiags)
+	mapVksClusterToResourceModel(ctx, &currentCluster, nil, &liveModel, &mappingDiags)
 	if mappingDiags.HasError() {
 		// Mapping the live object failed — skip dry-run rather than surfacing an
 		// internal error that would block the plan.
@@ -588,8 +633,8 @@ func (r *vcfaVksClusterResource) ModifyPlan(ctx context.Context, req resource.Mo
 	}
 	liveModel.Name = plan.Name
 	liveModel.Context = plan.Context
-	liveModel.Labels = filterToUserManagedKeys(ctx, currentCluster.Labels, plan.Labels, &mappingDiags)
-	liveModel.Annotations = filterToUserManagedKeys(ctx, currentCluster.Annotations, plan.Annotations, &mappingDiags)
+	liveModel.Labels = kubernetes.FilterToUserManagedKeys(ctx, currentCluster.Labels, plan.Labels, &mappingDiags)
+	liveModel.Annotations = kubernetes.FilterToUserManagedKeys(ctx, currentCluster.Annotations, plan.Annotations, &mappingDiags)
 	if mappingDiags.HasError() {
 		return
 	}
@@ -725,7 +770,7 @@ func (r *vcfaVksClusterResource) createMergePatch(ctx context.Context, state vcf
 	// cluster — including backend-injected ones.  Replace the coarse-grained
 	// null with a precise per-key diff (removed keys → null, added/changed
 	// keys → new value) so that only user-managed keys are affected.
-	patchBytes, err = injectPerKeyMapDiffs(ctx, patchBytes, state, plan, &diags)
+	patchBytes, err = kubernetes.InjectPerKeyMapDiffs(ctx, patchBytes, state.Labels, plan.Labels, state.Annotations, plan.Annotations, &diags)
 	if err != nil {
 		diags.AddError(
 			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVksCluster, name),
@@ -737,98 +782,12 @@ func (r *vcfaVksClusterResource) createMergePatch(ctx context.Context, state vcf
 	return patchBytes, diags
 }
 
-func (r *vcfaVksClusterResource) extractWaitForAvailable(ctx context.Context, waitForObj types.Object) (bool, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	if waitForObj.IsNull() || waitForObj.IsUnknown() {
-		return false, diags
-	}
-	var wf vksClusterWaitForModel
-	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
-	if wf.Available.IsNull() || wf.Available.IsUnknown() {
-		return false, diags
+// listVksClusterMachineDeployments returns the MachineDeployments owned by the given cluster.
+func listVksClusterMachineDeployments(ctx context.Context, k8sClient *kubernetes.Client, namespace string, clusterName string) ([]vcfatypes.VksMachineDeployment, error) {
+	var machineDeployments vcfatypes.VksMachineDeploymentList
+	labelSelector := fmt.Sprintf("%s=%s", vcfatypes.VksClusterNameLabel, clusterName)
+	if err := k8sClient.ListNamespaceScopedResources(ctx, namespace, vcfatypes.GetVksMachineDeploymentGVR(), labelSelector, &machineDeployments); err != nil {
+		return nil, err
 	}
-	return wf.Available.ValueBool(), diags
-}
-
-func (r *vcfaVksClusterResource) waitForClusterAvailable(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, timeout time.Duration) error {
-	const (
-		vksClusterStateAvailable    = "Available"
-		vksClusterStateNotAvailable = "NotAvailable"
-	)
-
-	conf := &retry.StateChangeConf{
-		Pending:      []string{vksClusterStateNotAvailable},
-		Target:       []string{vksClusterStateAvailable},
-		Timeout:      timeout,
-		PollInterval: vksClusterPollInterval,
-		Refresh: func() (any, string, error) {
-			var cluster vcfatypes.VksCluster
-			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVksClusterGVR(), &cluster); err != nil {
-				if apierrors.IsNotFound(err) {
-					return nil, "", fmt.Errorf("%s %s in VCF context %s/%s not found while waiting to become available", vcfatypes.LabelVksCluster, name, projectName, namespace)
-				}
-				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to become available: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
-			}
-
-			if kubernetes.IsConditionTrue(cluster.Status.Conditions, vcfatypes.VksConditionAvailable) {
-				return &cluster, vksClusterStateAvailable, nil
-			}
-			condition := kubernetes.FindCondition(cluster.Status.Conditions, vcfatypes.VksConditionAvailable)
-			if condition != nil {
-				log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to become %s (reason: %s - lastTransitionTime: %s - message: %s)", vcfatypes.LabelVksCluster, name, projectName, namespace, vcfatypes.VksConditionAvailable, condition.Reason, condition.LastTransitionTime, condition.Message)
-			} else {
-				log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to become %s", vcfatypes.LabelVksCluster, name, projectName, namespace, vcfatypes.VksConditionAvailable)
-			}
-			return &cluster, vksClusterStateNotAvailable, nil
-		},
-	}
-
-	if _, err := conf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be available: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
-	}
-	return nil
-}
-
-func (r *vcfaVksClusterResource) extractWaitForDeleted(ctx context.Context, waitForObj types.Object) (bool, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	if waitForObj.IsNull() || waitForObj.IsUnknown() {
-		return false, diags
-	}
-	var wf vksClusterWaitForModel
-	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
-	if wf.Deleted.IsNull() || wf.Deleted.IsUnknown() {
-		return false, diags
-	}
-	return wf.Deleted.ValueBool(), diags
-}
-
-func (r *vcfaVksClusterResource) waitForClusterDeleted(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, deleteTimeout time.Duration) error {
-	const (
-		vksClusterStateExists  = "Exists"
-		vksClusterStateDeleted = "Deleted"
-	)
-
-	conf := &retry.StateChangeConf{
-		Pending:      []string{vksClusterStateExists},
-		Target:       []string{vksClusterStateDeleted},
-		Timeout:      deleteTimeout,
-		PollInterval: vksClusterPollInterval,
-		Refresh: func() (any, string, error) {
-			var cluster vcfatypes.VksCluster
-			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVksClusterGVR(), &cluster); err != nil {
-				if apierrors.IsNotFound(err) {
-					return "", vksClusterStateDeleted, nil
-				}
-				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to be deleted: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
-			}
-			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to be deleted (deletionTimestamp: %s - finalizers: %s)", vcfatypes.LabelVksCluster, name, projectName, namespace, cluster.DeletionTimestamp, cluster.Finalizers)
-			return &cluster, vksClusterStateExists, nil
-		},
-	}
-
-	if _, err := conf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be deleted: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
-	}
-
-	return nil
+	return machineDeployments.Items, nil
 }
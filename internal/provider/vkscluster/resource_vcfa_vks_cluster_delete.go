@@ -0,0 +1,108 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vkscluster
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// checkDeletionProtection fails the plan when it destroys or replaces a cluster
+// whose state has deletion_protection enabled.
+func (r *vcfaVksClusterResource) checkDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to protect on create.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var protection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protection)...)
+	if resp.Diagnostics.HasError() || !protection.ValueBool() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("cannot destroy %s %s", vcfatypes.LabelVksCluster, name.ValueString()),
+			"deletion_protection is enabled. Set it to false and apply the change before destroying the cluster.",
+		)
+		return
+	}
+
+	if len(resp.RequiresReplace) > 0 {
+		replaced := make([]string, 0, len(resp.RequiresReplace))
+		for _, p := range resp.RequiresReplace {
+			replaced = append(replaced, p.String())
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("cannot replace %s %s", vcfatypes.LabelVksCluster, name.ValueString()),
+			fmt.Sprintf("deletion_protection is enabled and the plan replaces the cluster because of changes to: %s. "+
+				"Set deletion_protection to false and apply the change before replacing the cluster.", strings.Join(replaced, ", ")),
+		)
+	}
+}
+
+// checkVksClusterWorkloadBeforeDelete connects to the workload cluster with its
+// kubeconfig and returns an error when it still has PersistentVolumes or
+// LoadBalancer Services, as deleting the cluster would lose them. Clusters whose
+// kubeconfig was never generated have no workloads and pass the check.
+func checkVksClusterWorkloadBeforeDelete(ctx context.Context, k8sClient *kubernetes.Client, namespace string, name string) error {
+	secretName := name + vcfatypes.VksClusterKubeconfigSecretSuffix
+	secret, err := k8sClient.ReadSecret(ctx, namespace, secretName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[DEBUG] kubeconfig secret %s/%s not found, skipping pre-delete check of %s %s", namespace, secretName, vcfatypes.LabelVksCluster, name)
+			return nil
+		}
+		return fmt.Errorf("could not read kubeconfig secret %s: %w", secretName, err)
+	}
+	kubeconfig, ok := secret.Data[vcfatypes.VksClusterKubeconfigSecretDataKey]
+	if !ok {
+		return fmt.Errorf("secret %s does not contain the %s key", secretName, vcfatypes.VksClusterKubeconfigSecretDataKey)
+	}
+
+	workloadClient, err := kubernetes.NewClientFromKubeconfig(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("could not connect to the workload cluster: %w", err)
+	}
+
+	volumes, err := workloadClient.ListPersistentVolumes(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list the PersistentVolumes of the workload cluster: %w", err)
+	}
+	services, err := workloadClient.ListServices(ctx, "")
+	if err != nil {
+		return fmt.Errorf("could not list the Services of the workload cluster: %w", err)
+	}
+
+	var blockers []string
+	for _, pv := range volumes.Items {
+		blockers = append(blockers, fmt.Sprintf("PersistentVolume %s", pv.Name))
+	}
+	for _, svc := range services.Items {
+		if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			blockers = append(blockers, fmt.Sprintf("LoadBalancer Service %s/%s", svc.Namespace, svc.Name))
+		}
+	}
+	if len(blockers) > 0 {
+		return fmt.Errorf("the workload cluster still has %d resource(s) that would be lost: %s. "+
+			"Remove them or set pre_delete_check to false", len(blockers), strings.Join(blockers, ", "))
+	}
+	return nil
+}
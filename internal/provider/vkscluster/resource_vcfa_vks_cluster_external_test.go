@@ -7,6 +7,7 @@
 package vkscluster_test
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -20,8 +21,8 @@ import (
 )
 
 // TestAccVcfaVksClusterResourceExternal exercises the full lifecycle
-// (create → update → datasource read → import → destroy) of the vcfa_vks_cluster
-// resource against a live environment.
+// (create → update → datasource read → import → protected destroy → orphan → destroy)
+// of the vcfa_vks_cluster resource against a live environment.
 func TestAccVcfaVksClusterResourceExternal(t *testing.T) {
 	testutils.SkipIfSysAdmin(t)
 
@@ -51,6 +52,9 @@ func TestAccVcfaVksClusterResourceExternal(t *testing.T) {
 		"ControlPlaneReplicas":  cfg.Vks.ControlPlaneReplicas,
 		"WorkerReplicas":        cfg.Vks.WorkerReplicas,
 		"WorkerReplicasUpdated": workerReplicasUpdated,
+
+		"DeletionProtection": "false",
+		"DeletionPolicy":     "delete",
 	}
 	testutils.TestParamsNotEmpty(t, params)
 
@@ -59,10 +63,26 @@ func TestAccVcfaVksClusterResourceExternal(t *testing.T) {
 	configText2 := testutils.TemplateFill(t, testAccVcfaVksClusterExternalConfigUpdate, params)
 	params["FuncName"] = t.Name() + "-ds"
 	configText3 := testutils.TemplateFill(t, testAccVcfaVksClusterExternalConfigWithDatasource, params)
+	params["FuncName"] = t.Name() + "-protected"
+	params["DeletionProtection"] = "true"
+	configText5 := testutils.TemplateFill(t, testAccVcfaVksClusterExternalConfigUpdate, params)
+	params["FuncName"] = t.Name() + "-orphan"
+	params["DeletionProtection"] = "false"
+	params["DeletionPolicy"] = "orphan"
+	configText7 := testutils.TemplateFill(t, testAccVcfaVksClusterExternalConfigUpdate, params)
+	params["FuncName"] = t.Name() + "-orphaned"
+	configText9 := testutils.TemplateFill(t, testAccVcfaVksClusterExternalConfigOrphaned, params)
 
 	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
 	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
 	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step5: %s\n", configText5)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step7: %s\n", configText7)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION step9: %s\n", configText9)
+
+	importId := func(s *terraform.State) (string, error) {
+		return params["Project"].(string) + vcfa.ImportSeparator + params["Namespace"].(string) + vcfa.ImportSeparator + params["ClusterName"].(string), nil
+	}
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
@@ -93,6 +113,7 @@ func TestAccVcfaVksClusterResourceExternal(t *testing.T) {
 				Config: configText2,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("vcfa_vks_cluster.test", "machine_deployments.0.replicas", params["WorkerReplicasUpdated"].(string)),
+					resource.TestCheckResourceAttr("vcfa_vks_cluster.test", "wait_for.conditions.0.status", "True"),
 				),
 			},
 			// Step 3: verify the data source reflects the updated state.
@@ -128,6 +149,36 @@ func TestAccVcfaVksClusterResourceExternal(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.vcfa_vks_cluster.test", "status.phase"),
 					resource.TestCheckResourceAttrSet("data.vcfa_vks_cluster.test", "status.observed_generation"),
 
+					// vcfa_vks_clusters datasource checks.
+					resource.TestCheckResourceAttrSet("data.vcfa_vks_clusters.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcfa_vks_clusters.test", "clusters.*", map[string]string{
+						"name":                    clusterName,
+						"cluster_class":           params["ClusterClassName"].(string),
+						"version":                 params["KubernetesVersion"].(string),
+						"control_plane_replicas":  params["ControlPlaneReplicas"].(string),
+						"worker_pools.#":          "1",
+						"worker_pools.0.name":     "default",
+						"worker_pools.0.replicas": params["WorkerReplicasUpdated"].(string),
+						"available.status":        "True",
+					}),
+
+					// vcfa_vks_cluster_machines datasource checks.
+					resource.TestCheckResourceAttrSet("data.vcfa_vks_cluster_machines.test", "id"),
+					resource.TestCheckResourceAttr("data.vcfa_vks_cluster_machines.test", "machine_deployments.#", "1"),
+					resource.TestCheckResourceAttr("data.vcfa_vks_cluster_machines.test", "machine_deployments.0.pool", "default"),
+					resource.TestCheckResourceAttr("data.vcfa_vks_cluster_machines.test", "machine_deployments.0.ready_replicas", params["WorkerReplicasUpdated"].(string)),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcfa_vks_cluster_machines.test", "machines.*", map[string]string{
+						"cluster_name":  clusterName,
+						"control_plane": "true",
+						"phase":         "Running",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcfa_vks_cluster_machines.test", "machines.*", map[string]string{
+						"cluster_name":  clusterName,
+						"control_plane": "false",
+						"pool":          "default",
+						"phase":         "Running",
+					}),
+
 					// vcfa_vks_cluster_kubeconfig datasource checks.
 					resource.TestCheckResourceAttrSet("data.vcfa_vks_cluster_kubeconfig.test", "id"),
 					resource.TestCheckResourceAttr("data.vcfa_vks_cluster_kubeconfig.test", "context.project", params["Project"].(string)),
@@ -144,11 +195,12 @@ func TestAccVcfaVksClusterResourceExternal(t *testing.T) {
 				ResourceName:      "vcfa_vks_cluster.test",
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateIdFunc: func(s *terraform.State) (string, error) {
-					return params["Project"].(string) + vcfa.ImportSeparator + params["Namespace"].(string) + vcfa.ImportSeparator + params["ClusterName"].(string), nil
-				},
+				ImportStateIdFunc: importId,
 				ImportStateVerifyIgnore: []string{
 					"dry_run_validation",     // local-only
+					"deletion_protection",    // local-only
+					"deletion_policy",        // local-only
+					"pre_delete_check",       // local-only
 					"wait_for",               // local-only
 					"timeouts",               // local-only
 					"metadata",               // computed-only
@@ -157,6 +209,46 @@ func TestAccVcfaVksClusterResourceExternal(t *testing.T) {
 					"status",                 // computed-only
 				},
 			},
+			// Step 5: enable deletion protection.
+			{
+				Config: configText5,
+				Check:  resource.TestCheckResourceAttr("vcfa_vks_cluster.test", "deletion_protection", "true"),
+			},
+			// Step 6: destroying the protected cluster fails during plan.
+			{
+				Config:      configText5,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion_protection is enabled`),
+			},
+			// Step 7: disable deletion protection and orphan the cluster on destroy.
+			{
+				Config: configText7,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_vks_cluster.test", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("vcfa_vks_cluster.test", "deletion_policy", "orphan"),
+				),
+			},
+			// Step 8: destroying the orphaned cluster only removes it from the state.
+			{
+				Config:  configText7,
+				Destroy: true,
+			},
+			// Step 9: the cluster still exists after the destroy.
+			{
+				Config: configText9,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vcfa_vks_cluster.orphaned", "name", clusterName),
+					resource.TestCheckResourceAttrSet("data.vcfa_vks_cluster.orphaned", "metadata.uid"),
+				),
+			},
+			// Step 10: import the cluster back, so that the final destroy deletes it.
+			{
+				Config:             configText2,
+				ResourceName:       "vcfa_vks_cluster.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc:  importId,
+			},
 		},
 	})
 }
@@ -214,7 +306,8 @@ resource "vcfa_vks_cluster" "test" {
 `
 
 // testAccVcfaVksClusterExternalConfigUpdate is the Step 2 (update) HCL template.
-// It scales the worker node count to WorkerReplicasUpdated.
+// It scales the worker node count to WorkerReplicasUpdated. Steps 5 and 7 change
+// its deletion settings.
 const testAccVcfaVksClusterExternalConfigUpdate = `
 resource "vcfa_vks_cluster" "test" {
   context = {
@@ -224,9 +317,20 @@ resource "vcfa_vks_cluster" "test" {
 
   name = "{{.ClusterName}}"
 
+  deletion_protection = {{.DeletionProtection}}
+  deletion_policy     = "{{.DeletionPolicy}}"
+
   wait_for = {
-    available = true
-    deleted   = true
+    available           = true
+    deleted             = true
+    topology_reconciled = true
+    conditions = [
+      { type = "WorkersAvailable" },
+    ]
+    min_ready_workers = {
+      default = {{.WorkerReplicasUpdated}}
+    }
+    fail_on_reasons = ["TopologyReconcileFailed"]
   }
 
   cluster_class = {
@@ -266,9 +370,9 @@ resource "vcfa_vks_cluster" "test" {
 }
 `
 
-// testAccVcfaVksClusterExternalConfigWithDatasource adds a vcfa_vks_cluster and a
-// vcfa_vks_cluster_kubeconfig data source to the Step 2 configuration so Step 3
-// can verify both datasources read back the correct state. The cluster must be
+// testAccVcfaVksClusterExternalConfigWithDatasource adds vcfa_vks_cluster, vcfa_vks_clusters,
+// vcfa_vks_cluster_machines and vcfa_vks_cluster_kubeconfig data sources to the Step 2 configuration so Step 3
+// can verify the datasources read back the correct state. The cluster must be
 // Available before this step runs (guaranteed by wait_for.available = true in
 // Step 2) because the kubeconfig secret is only created once the cluster is
 // provisioned.
@@ -282,6 +386,15 @@ data "vcfa_vks_cluster" "test" {
   name = vcfa_vks_cluster.test.name
 }
 
+data "vcfa_vks_clusters" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  depends_on = [vcfa_vks_cluster.test]
+}
+
 data "vcfa_vks_cluster_kubeconfig" "test" {
   context = {
     project   = "{{.Project}}"
@@ -290,4 +403,27 @@ data "vcfa_vks_cluster_kubeconfig" "test" {
 
   name = vcfa_vks_cluster.test.name
 }
+
+data "vcfa_vks_cluster_machines" "test" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  cluster_name = vcfa_vks_cluster.test.name
+}
+`
+
+// testAccVcfaVksClusterExternalConfigOrphaned is the Step 9 HCL template. It only
+// reads the cluster, to verify that destroying it with deletion_policy = "orphan"
+// did not delete it.
+const testAccVcfaVksClusterExternalConfigOrphaned = `
+data "vcfa_vks_cluster" "orphaned" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+
+  name = "{{.ClusterName}}"
+}
 `
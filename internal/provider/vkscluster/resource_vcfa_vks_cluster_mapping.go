@@ -12,7 +12,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -29,7 +28,11 @@ import (
 )
 
 // ── API → Terraform state ────────────────────────────────────────────────────
-func mapVksClusterToResourceModel(ctx context.Context, cluster *vcfatypes.VksCluster, model *vcfaVksClusterResourceModel, diags *diag.Diagnostics) {
+//
+// machineDeployments are the MachineDeployments generated from the cluster
+// topology; they are only used to report the per-pool replica counts in
+// status.machine_deployments and may be nil when those are not needed.
+func mapVksClusterToResourceModel(ctx context.Context, cluster *vcfatypes.VksCluster, machineDeployments []vcfatypes.VksMachineDeployment, model *vcfaVksClusterResourceModel, diags *diag.Diagnostics) {
 	// Metadata
 	model.Metadata = helpers.ObjFrom(ctx, kubernetes.MetadataAttrTypes,
 		kubernetes.MapMetadataToModel(ctx, cluster.ObjectMeta, diags), diags)
@@ -89,7 +92,7 @@ func mapVksClusterToResourceModel(ctx context.Context, cluster *vcfatypes.VksClu
 	}
 
 	// Status
-	model.Status = mapClusterStatusToModel(ctx, cluster, diags)
+	model.Status = mapClusterStatusToModel(ctx, cluster, machineDeployments, diags)
 }
 
 // ── Terraform state → API object ─────────────────────────────────────────────
@@ -379,11 +382,14 @@ func mapClusterTopologyToModel(ctx context.Context, topology vcfatypes.VksCluste
 
 	model.ControlPlane = mapControlPlaneTopologyToModel(ctx, topology.ControlPlane, diags)
 
+	// Must be computed before model.MachineDeployments is overwritten below.
+	poolsWithoutAutoscaler := getMachineDeploymentsWithoutAutoscaler(ctx, model.MachineDeployments, diags)
+
 	model.MachineDeployments = types.SetNull(types.ObjectType{AttrTypes: vksMachineDeploymentTopologyAttrTypes})
 	if len(topology.Workers.MachineDeployments) > 0 {
 		mdModels := make([]vksClusterMachineDeploymentTopologyModel, 0, len(topology.Workers.MachineDeployments))
 		for _, md := range topology.Workers.MachineDeployments {
-			mdModels = append(mdModels, mapMachineDeploymentTopologyToModel(ctx, md, diags))
+			mdModels = append(mdModels, mapMachineDeploymentTopologyToModel(ctx, md, poolsWithoutAutoscaler[md.Name], diags))
 		}
 		model.MachineDeployments = helpers.SetFrom(ctx, types.ObjectType{AttrTypes: vksMachineDeploymentTopologyAttrTypes}, mdModels, diags)
 	}
@@ -613,15 +619,32 @@ func mapControlPlaneHealthCheckFromModel(ctx context.Context, hcObj types.Object
 
 // ── Machine Deployment mapping helpers ──────────────────────────────---------
 
-func mapMachineDeploymentTopologyToModel(ctx context.Context, md vcfatypes.VksMachineDeploymentTopology, diags *diag.Diagnostics) vksClusterMachineDeploymentTopologyModel {
+// mapMachineDeploymentTopologyToModel maps a MachineDeployment topology entry to its model. When
+// keepAutoscalerAnnotations is true, the pool was configured without the autoscaler block, so the
+// autoscaler annotations are kept in metadata.annotations, where the user set them, instead of being
+// moved to the autoscaler attribute.
+func mapMachineDeploymentTopologyToModel(ctx context.Context, md vcfatypes.VksMachineDeploymentTopology, keepAutoscalerAnnotations bool, diags *diag.Diagnostics) vksClusterMachineDeploymentTopologyModel {
+	autoscalerManaged := hasAutoscalerAnnotations(md.Metadata.Annotations)
+
 	// mapOsImageToModel and mapAutoscalerToModel both delete their annotation
 	// keys from the map in-place, so they must be called before
 	// mapObjectMetaToModel to prevent the annotations from leaking into
 	// metadata.annotations in state.
 	osImage := mapOsImageToModel(ctx, md.Metadata.Annotations, diags)
-	autoscaler := mapAutoscalerToModel(ctx, md.Metadata.Annotations, diags)
+	autoscaler := types.ObjectNull(vksClusterAutoscalerAttrTypes)
+	if !keepAutoscalerAnnotations {
+		autoscaler = mapAutoscalerToModel(ctx, md.Metadata.Annotations, diags)
+	}
 	metadata := mapObjectMetaToModel(ctx, md.Metadata, diags)
 
+	// Replicas of a pool managed by the cluster autoscaler belong to the
+	// autoscaler: never track them, otherwise every scale event shows up as
+	// drift. The actual size is reported in status.machine_deployments.
+	replicas := types.Int32PointerValue(md.Replicas)
+	if autoscalerManaged {
+		replicas = types.Int32Null()
+	}
+
 	failureDomain := types.StringNull()
 	if md.FailureDomain != "" {
 		failureDomain = types.StringValue(md.FailureDomain)
@@ -650,7 +673,7 @@ func mapMachineDeploymentTopologyToModel(ctx context.Context, md vcfatypes.VksMa
 		Class:             types.StringValue(md.Class),
 		Name:              types.StringValue(md.Name),
 		FailureDomain:     failureDomain,
-		Replicas:          types.Int32PointerValue(md.Replicas),
+		Replicas:          replicas,
 		Autoscaler:        autoscaler,
 		HealthCheck:       healthCheck,
 		Deletion:          deletion,
@@ -680,7 +703,9 @@ func mapMachineDeploymentTopologyFromModel(ctx context.Context, md vksClusterMac
 		mdTopology.FailureDomain = md.FailureDomain.ValueString()
 	}
 
-	if !md.Replicas.IsNull() && !md.Replicas.IsUnknown() {
+	// Replicas are left out of the request for pools managed by the cluster
+	// autoscaler, so that applying a plan never resets the size it has chosen.
+	if !md.Replicas.IsNull() && !md.Replicas.IsUnknown() && !isAutoscalerManaged(ctx, md.Autoscaler, diags) {
 		mdTopology.Replicas = md.Replicas.ValueInt32Pointer()
 	}
 
@@ -916,7 +941,7 @@ func mapMachineDeploymentRolloutFromModel(ctx context.Context, rolloutObj types.
 
 // ── Status mapping helpers ───────────────────────────────────────────────────
 
-func mapClusterStatusToModel(ctx context.Context, cluster *vcfatypes.VksCluster, diags *diag.Diagnostics) types.Object {
+func mapClusterStatusToModel(ctx context.Context, cluster *vcfatypes.VksCluster, machineDeployments []vcfatypes.VksMachineDeployment, diags *diag.Diagnostics) types.Object {
 	conditions := helpers.SetFrom(ctx,
 		types.ObjectType{AttrTypes: kubernetes.ConditionAttrTypes},
 		kubernetes.MapConditionsToModel(ctx, cluster.Status.Conditions, diags),
@@ -981,12 +1006,36 @@ func mapClusterStatusToModel(ctx context.Context, cluster *vcfatypes.VksCluster,
 		ControlPlane:       helpers.ObjFrom(ctx, vksClusterStatusControlPlaneStatusAttrTypes, &controlPlaneStatusModel, diags),
 		Workers:            helpers.ObjFrom(ctx, vksClusterStatusWorkersStatusAttrTypes, &workersStatusModel, diags),
 		FailureDomains:     failureDomains,
+		MachineDeployments: mapMachineDeploymentStatusesToModel(ctx, machineDeployments, diags),
 		Phase:              types.StringValue(cluster.Status.Phase),
 		ObservedGeneration: types.Int64Value(cluster.Status.ObservedGeneration),
 	}
 	return helpers.ObjFrom(ctx, vksClusterStatusAttrTypes, &statusModel, diags)
 }
 
+// mapMachineDeploymentStatusesToModel reports the actual replica counts of every
+// MachineDeployment generated from the cluster topology. Each entry is named after
+// the machine_deployments topology entry it belongs to, so that pools scaled by the
+// cluster autoscaler still expose their current size.
+func mapMachineDeploymentStatusesToModel(ctx context.Context, machineDeployments []vcfatypes.VksMachineDeployment, diags *diag.Diagnostics) types.Set {
+	models := make([]vksClusterStatusMachineDeploymentModel, 0, len(machineDeployments))
+	for _, md := range machineDeployments {
+		name := md.Labels[vcfatypes.VksTopologyMachineDeploymentNameLabel]
+		if name == "" {
+			name = md.Name
+		}
+		models = append(models, vksClusterStatusMachineDeploymentModel{
+			Name:              types.StringValue(name),
+			DesiredReplicas:   types.Int32PointerValue(md.Spec.Replicas),
+			Replicas:          types.Int32PointerValue(md.Status.Replicas),
+			UpToDateReplicas:  types.Int32PointerValue(md.Status.UpToDateReplicas),
+			ReadyReplicas:     types.Int32PointerValue(md.Status.ReadyReplicas),
+			AvailableReplicas: types.Int32PointerValue(md.Status.AvailableReplicas),
+		})
+	}
+	return helpers.SetFrom(ctx, types.ObjectType{AttrTypes: vksClusterStatusMachineDeploymentAttrTypes}, models, diags)
+}
+
 // ── OS image annotation helpers ──────────────────────────────────────────────
 
 func mapOsImageToModel(ctx context.Context, annotations map[string]string, diags *diag.Diagnostics) types.Object {
@@ -1087,6 +1136,46 @@ func mapAutoscalerToModel(ctx context.Context, annotations map[string]string, di
 	}, diags)
 }
 
+// hasAutoscalerAnnotations returns true when the annotations contain any of the
+// cluster autoscaler min/max size annotation keys.
+func hasAutoscalerAnnotations(annotations map[string]string) bool {
+	_, hasMin := annotations[vcfatypes.AutoscalerMinSizeAnnotationKey]
+	_, hasMax := annotations[vcfatypes.AutoscalerMaxSizeAnnotationKey]
+	return hasMin || hasMax
+}
+
+// getMachineDeploymentsWithoutAutoscaler returns the names of the machine_deployments
+// entries of the given plan or state that do not set the autoscaler block. Those pools
+// keep their autoscaler annotations in metadata.annotations when read back, so that
+// the state matches a configuration that sets the annotations directly.
+func getMachineDeploymentsWithoutAutoscaler(ctx context.Context, machineDeployments types.Set, diags *diag.Diagnostics) map[string]bool {
+	result := make(map[string]bool)
+	if machineDeployments.IsNull() || machineDeployments.IsUnknown() {
+		return result
+	}
+
+	var mdModels []vksClusterMachineDeploymentTopologyModel
+	diags.Append(machineDeployments.ElementsAs(ctx, &mdModels, false)...)
+	for _, md := range mdModels {
+		if md.Autoscaler.IsNull() {
+			result[md.Name.ValueString()] = true
+		}
+	}
+	return result
+}
+
+// isAutoscalerManaged returns true when the autoscaler block sets at least one
+// of min_size / max_size, i.e. when the cluster autoscaler owns the replicas.
+func isAutoscalerManaged(ctx context.Context, autoscalerObj types.Object, diags *diag.Diagnostics) bool {
+	if autoscalerObj.IsNull() || autoscalerObj.IsUnknown() {
+		return false
+	}
+
+	var m vksClusterAutoscalerModel
+	diags.Append(autoscalerObj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	return !m.MinSize.IsNull() || !m.MaxSize.IsNull()
+}
+
 func injectAutoscalerAnnotations(ctx context.Context, autoscalerObj types.Object, annotations map[string]string, diags *diag.Diagnostics) map[string]string {
 	if autoscalerObj.IsNull() || autoscalerObj.IsUnknown() {
 		return annotations
@@ -1112,120 +1201,6 @@ func injectAutoscalerAnnotations(ctx context.Context, autoscalerObj types.Object
 	return result
 }
 
-// ── User-managed labels/annotations helpers ──────────────────────────────────
-
-// filterToUserManagedKeys returns a types.Map whose keys are restricted to those
-// that were present in priorState.  For each such key the value from the live API
-// map (apiMap) is used so that actual server-side values are reflected.  Keys that
-// the API no longer has are silently dropped (Terraform will show an add-diff on
-// the next plan if they are still present in config).  When priorState is null/unknown
-// or all keys are absent from the API the result is types.MapNull.
-func filterToUserManagedKeys(ctx context.Context, apiMap map[string]string, priorState types.Map, diags *diag.Diagnostics) types.Map {
-	if priorState.IsNull() || priorState.IsUnknown() || len(priorState.Elements()) == 0 {
-		return types.MapNull(types.StringType)
-	}
-	var priorKeys map[string]string
-	diags.Append(priorState.ElementsAs(ctx, &priorKeys, false)...)
-	if diags.HasError() {
-		return types.MapNull(types.StringType)
-	}
-	result := make(map[string]attr.Value, len(priorKeys))
-	for k := range priorKeys {
-		if v, ok := apiMap[k]; ok {
-			result[k] = types.StringValue(v)
-		}
-	}
-	if len(result) == 0 {
-		return types.MapNull(types.StringType)
-	}
-	filtered, d := types.MapValue(types.StringType, result)
-	diags.Append(d...)
-	return filtered
-}
-
-// injectPerKeyMapDiffs post-processes a JSON merge-patch so that
-// metadata.labels and metadata.annotations are expressed as per-key diffs
-// rather than a single null (which would wipe all backend-managed keys).
-func injectPerKeyMapDiffs(ctx context.Context, patchBytes []byte, state, plan vcfaVksClusterResourceModel, diags *diag.Diagnostics) ([]byte, error) {
-	labelDiff := computePerKeyMapDiff(ctx, state.Labels, plan.Labels, diags)
-	annotationDiff := computePerKeyMapDiff(ctx, state.Annotations, plan.Annotations, diags)
-
-	if len(labelDiff) == 0 && len(annotationDiff) == 0 {
-		return patchBytes, nil
-	}
-
-	var patchMap map[string]any
-	if err := json.Unmarshal(patchBytes, &patchMap); err != nil {
-		return patchBytes, err
-	}
-
-	// Ensure there is a "metadata" map in the patch.
-	var metadata map[string]any
-	if existing, ok := patchMap["metadata"]; ok {
-		if m, ok := existing.(map[string]any); ok {
-			metadata = m
-		} else {
-			metadata = map[string]any{}
-		}
-	} else {
-		metadata = map[string]any{}
-	}
-
-	if len(labelDiff) > 0 {
-		metadata["labels"] = labelDiff
-	} else {
-		// No user-managed label changes: ensure we don't accidentally send a
-		// coarse {"labels": null} from the raw merge-patch.
-		delete(metadata, "labels")
-	}
-
-	if len(annotationDiff) > 0 {
-		metadata["annotations"] = annotationDiff
-	} else {
-		delete(metadata, "annotations")
-	}
-
-	if len(metadata) > 0 {
-		patchMap["metadata"] = metadata
-	} else {
-		delete(patchMap, "metadata")
-	}
-
-	return json.Marshal(patchMap)
-}
-
-// computePerKeyMapDiff returns a map[string]any suitable for embedding in a JSON
-// merge-patch (RFC 7396).  Keys that exist in oldMap but are absent from newMap
-// are set to nil (which serialises as JSON null, signalling deletion to the API).
-// Keys that are new or have changed values in newMap are set to their new string
-// value.  Unchanged keys are omitted so the patch is minimal.
-// This avoids sending {"labels": null} which would erase ALL labels – including
-// backend-injected ones – when the user simply removes their last label.
-func computePerKeyMapDiff(ctx context.Context, oldMap, newMap types.Map, diags *diag.Diagnostics) map[string]any {
-	var oldKeys, newKeys map[string]string
-	if !oldMap.IsNull() && !oldMap.IsUnknown() {
-		diags.Append(oldMap.ElementsAs(ctx, &oldKeys, false)...)
-	}
-	if !newMap.IsNull() && !newMap.IsUnknown() {
-		diags.Append(newMap.ElementsAs(ctx, &newKeys, false)...)
-	}
-	if len(oldKeys) == 0 && len(newKeys) == 0 {
-		return nil
-	}
-	result := make(map[string]any)
-	for k := range oldKeys {
-		if _, ok := newKeys[k]; !ok {
-			result[k] = nil // JSON null → remove from API
-		}
-	}
-	for k, v := range newKeys {
-		if oldV, ok := oldKeys[k]; !ok || oldV != v {
-			result[k] = v
-		}
-	}
-	return result
-}
-
 // isBackendInjectedVariable returns true when the variable was automatically injected
 // by the backend platform and the user has not customised it with additional keys.
 // A variable is considered backend-injected when its name is in backendInjectedVariableKeys
@@ -0,0 +1,129 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vkscluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// testAutoscaledMachineDeployment returns a MachineDeployment topology entry that is
+// scaled by the cluster autoscaler, as returned by the backend
+func testAutoscaledMachineDeployment(name string) vcfatypes.VksMachineDeploymentTopology {
+	replicas := int32(3)
+	md := vcfatypes.VksMachineDeploymentTopology{
+		Class:    "node-pool",
+		Name:     name,
+		Replicas: &replicas,
+	}
+	md.Metadata.Annotations = map[string]string{
+		vcfatypes.AutoscalerMinSizeAnnotationKey: "1",
+		vcfatypes.AutoscalerMaxSizeAnnotationKey: "5",
+		"custom":                                 "value",
+	}
+	return md
+}
+
+func TestMapMachineDeploymentTopologyToModelAutoscaler(t *testing.T) {
+	type testCase struct {
+		name                      string
+		keepAutoscalerAnnotations bool
+		expectedAutoscaler        bool
+		expectedAnnotations       map[string]string
+	}
+
+	testCases := []testCase{
+		{
+			name:               "AutoscalerBlock",
+			expectedAutoscaler: true,
+			expectedAnnotations: map[string]string{
+				"custom": "value",
+			},
+		},
+		{
+			name:                      "AnnotationsOnly",
+			keepAutoscalerAnnotations: true,
+			expectedAnnotations: map[string]string{
+				vcfatypes.AutoscalerMinSizeAnnotationKey: "1",
+				vcfatypes.AutoscalerMaxSizeAnnotationKey: "5",
+				"custom":                                 "value",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			var diags diag.Diagnostics
+			model := mapMachineDeploymentTopologyToModel(ctx, testAutoscaledMachineDeployment("pool"), tc.keepAutoscalerAnnotations, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !model.Replicas.IsNull() {
+				t.Errorf("expected null replicas for a pool scaled by the autoscaler, got %s", model.Replicas)
+			}
+
+			if model.Autoscaler.IsNull() == tc.expectedAutoscaler {
+				t.Fatalf("expected autoscaler to be set: %t, got %s", tc.expectedAutoscaler, model.Autoscaler)
+			}
+			if tc.expectedAutoscaler {
+				var autoscaler vksClusterAutoscalerModel
+				diags.Append(model.Autoscaler.As(ctx, &autoscaler, basetypes.ObjectAsOptions{})...)
+				if autoscaler.MinSize.ValueInt32() != 1 || autoscaler.MaxSize.ValueInt32() != 5 {
+					t.Errorf("expected autoscaler bounds 1-5, got %s-%s", autoscaler.MinSize, autoscaler.MaxSize)
+				}
+			}
+
+			var metadata vksClusterObjectMetaModel
+			diags.Append(model.Metadata.As(ctx, &metadata, basetypes.ObjectAsOptions{})...)
+			var annotations map[string]string
+			diags.Append(metadata.Annotations.ElementsAs(ctx, &annotations, false)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(annotations) != len(tc.expectedAnnotations) {
+				t.Fatalf("expected annotations %v, got %v", tc.expectedAnnotations, annotations)
+			}
+			for k, v := range tc.expectedAnnotations {
+				if annotations[k] != v {
+					t.Errorf("expected annotation %s=%s, got %q", k, v, annotations[k])
+				}
+			}
+		})
+	}
+}
+
+func TestGetMachineDeploymentsWithoutAutoscaler(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	prior := helpers.SetFrom(ctx, types.ObjectType{AttrTypes: vksMachineDeploymentTopologyAttrTypes}, []vksClusterMachineDeploymentTopologyModel{
+		mapMachineDeploymentTopologyToModel(ctx, testAutoscaledMachineDeployment("with-block"), false, &diags),
+		mapMachineDeploymentTopologyToModel(ctx, testAutoscaledMachineDeployment("annotations-only"), true, &diags),
+	}, &diags)
+
+	result := getMachineDeploymentsWithoutAutoscaler(ctx, prior, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(result) != 1 || !result["annotations-only"] {
+		t.Errorf("expected only annotations-only to be returned, got %v", result)
+	}
+
+	result = getMachineDeploymentsWithoutAutoscaler(ctx, types.SetNull(types.ObjectType{AttrTypes: vksMachineDeploymentTopologyAttrTypes}), &diags)
+	if len(result) != 0 {
+		t.Errorf("expected no entries for a null set, got %v", result)
+	}
+}
@@ -27,6 +27,11 @@ type vcfaVksClusterResourceModel struct {
 	// Wait controls
 	WaitFor types.Object `tfsdk:"wait_for"`
 
+	// Deletion controls
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DeletionPolicy     types.String `tfsdk:"deletion_policy"`
+	PreDeleteCheck     types.Bool   `tfsdk:"pre_delete_check"`
+
 	// Timeouts
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 
@@ -55,13 +60,39 @@ type vcfaVksClusterResourceModel struct {
 // ── Wait controls ─-----────-----─────────────────────────────────────────────
 
 type vksClusterWaitForModel struct {
-	Available types.Bool `tfsdk:"available"`
-	Deleted   types.Bool `tfsdk:"deleted"`
+	Available          types.Bool `tfsdk:"available"`
+	Deleted            types.Bool `tfsdk:"deleted"`
+	TopologyReconciled types.Bool `tfsdk:"topology_reconciled"`
+	Conditions         types.List `tfsdk:"conditions"`
+	MinReadyWorkers    types.Map  `tfsdk:"min_ready_workers"`
+	FailOnReasons      types.Set  `tfsdk:"fail_on_reasons"`
 }
 
 var vksClusterWaitForAttrTypes = map[string]attr.Type{
-	"available": types.BoolType,
-	"deleted":   types.BoolType,
+	"available":           types.BoolType,
+	"deleted":             types.BoolType,
+	"topology_reconciled": types.BoolType,
+	"conditions": types.ListType{
+		ElemType: types.ObjectType{
+			AttrTypes: vksClusterWaitForConditionAttrTypes,
+		},
+	},
+	"min_ready_workers": types.MapType{
+		ElemType: types.Int32Type,
+	},
+	"fail_on_reasons": types.SetType{
+		ElemType: types.StringType,
+	},
+}
+
+type vksClusterWaitForConditionModel struct {
+	Type   types.String `tfsdk:"type"`
+	Status types.String `tfsdk:"status"`
+}
+
+var vksClusterWaitForConditionAttrTypes = map[string]attr.Type{
+	"type":   types.StringType,
+	"status": types.StringType,
 }
 
 // ── Availability gates ───────────────────────────────────────────────────────
@@ -446,6 +477,7 @@ type vksClusterStatusModel struct {
 	ControlPlane       types.Object `tfsdk:"control_plane"`
 	Workers            types.Object `tfsdk:"workers"`
 	FailureDomains     types.Set    `tfsdk:"failure_domains"`
+	MachineDeployments types.Set    `tfsdk:"machine_deployments"`
 	Phase              types.String `tfsdk:"phase"`
 	ObservedGeneration types.Int64  `tfsdk:"observed_generation"`
 }
@@ -470,6 +502,11 @@ var vksClusterStatusAttrTypes = map[string]attr.Type{
 			AttrTypes: vksClusterStatusFailureDomainAttrTypes,
 		},
 	},
+	"machine_deployments": types.SetType{
+		ElemType: types.ObjectType{
+			AttrTypes: vksClusterStatusMachineDeploymentAttrTypes,
+		},
+	},
 	"phase":               types.StringType,
 	"observed_generation": types.Int64Type,
 }
@@ -530,6 +567,24 @@ var vksClusterStatusFailureDomainAttrTypes = map[string]attr.Type{
 	},
 }
 
+type vksClusterStatusMachineDeploymentModel struct {
+	Name              types.String `tfsdk:"name"`
+	DesiredReplicas   types.Int32  `tfsdk:"desired_replicas"`
+	Replicas          types.Int32  `tfsdk:"replicas"`
+	UpToDateReplicas  types.Int32  `tfsdk:"up_to_date_replicas"`
+	ReadyReplicas     types.Int32  `tfsdk:"ready_replicas"`
+	AvailableReplicas types.Int32  `tfsdk:"available_replicas"`
+}
+
+var vksClusterStatusMachineDeploymentAttrTypes = map[string]attr.Type{
+	"name":                types.StringType,
+	"desired_replicas":    types.Int32Type,
+	"replicas":            types.Int32Type,
+	"up_to_date_replicas": types.Int32Type,
+	"ready_replicas":      types.Int32Type,
+	"available_replicas":  types.Int32Type,
+}
+
 // ── Common ───────────────────────────────────────────────────────────────────
 
 type vksClusterMachineTaintModel struct {
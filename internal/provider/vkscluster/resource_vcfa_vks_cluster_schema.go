@@ -21,9 +21,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
@@ -80,6 +82,29 @@ func (r *vcfaVksClusterResource) Schema(ctx context.Context, _ resource.SchemaRe
 				Description: "When true, a dry-run Create or Update is sent to the backend during `terraform plan` and `terraform apply` to validate the cluster configuration before committing any changes. Backend validation errors are surfaced as plan errors. Defaults to false.",
 			},
 
+			// Deletion attributes
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, any plan that destroys or replaces the cluster fails. It must be set to false and applied before the cluster can be destroyed. Defaults to false.",
+			},
+			"deletion_policy": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(vksClusterDeletionPolicyDelete),
+				Description: fmt.Sprintf("What happens to the cluster when the resource is destroyed: '%s' (default) deletes it, '%s' only removes it from the Terraform state and leaves it running.", vksClusterDeletionPolicyDelete, vksClusterDeletionPolicyOrphan),
+				Validators: []validator.String{
+					stringvalidator.OneOf(vksClusterDeletionPolicyDelete, vksClusterDeletionPolicyOrphan),
+				},
+			},
+			"pre_delete_check": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, deleting the cluster is refused while its workload cluster still has PersistentVolumes or LoadBalancer Services. Defaults to false.",
+			},
+
 			// Wait attributes
 			"wait_for": schema.SingleNestedAttribute{
 				Optional:    true,
@@ -100,6 +125,49 @@ func (r *vcfaVksClusterResource) Schema(ctx context.Context, _ resource.SchemaRe
 						Default:     booldefault.StaticBool(false),
 						Description: "When true, Delete operation blocks until the cluster is fully removed. Set to false (default) to return immediately after the delete API call.",
 					},
+					"topology_reconciled": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "When true, Update operations block until the cluster's TopologyReconciled condition is True for the updated generation of the cluster.",
+					},
+					"conditions": schema.ListNestedAttribute{
+						Optional:    true,
+						Description: "Additional cluster conditions that Create and Update operations wait for (e.g. ControlPlaneInitialized, WorkersAvailable)",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Required:    true,
+									Description: "Type of the condition to wait for",
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(1, 316),
+									},
+								},
+								"status": schema.StringAttribute{
+									Optional:    true,
+									Computed:    true,
+									Default:     stringdefault.StaticString(string(metav1.ConditionTrue)),
+									Description: "Expected status of the condition: True (default), False, or Unknown",
+									Validators: []validator.String{
+										stringvalidator.OneOf(string(metav1.ConditionTrue), string(metav1.ConditionFalse), string(metav1.ConditionUnknown)),
+									},
+								},
+							},
+						},
+					},
+					"min_ready_workers": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.Int32Type,
+						Description: "Minimum number of ready worker machines that Create and Update operations wait for, keyed by machine_deployments name",
+						Validators: []validator.Map{
+							mapvalidator.ValueInt32sAre(int32validator.AtLeast(0)),
+						},
+					},
+					"fail_on_reasons": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Condition reasons considered terminal. Waiting stops with an error as soon as a condition being waited on reports one of them (e.g. TopologyReconcileFailed)",
+					},
 				},
 			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
@@ -944,6 +1012,41 @@ func (r *vcfaVksClusterResource) Schema(ctx context.Context, _ resource.SchemaRe
 							},
 						},
 					},
+					"machine_deployments": schema.SetNestedAttribute{
+						Computed:    true,
+						Description: "Actual replica counts of each worker MachineDeployment, including the ones scaled by the cluster autoscaler",
+						PlanModifiers: []planmodifier.Set{
+							setplanmodifier.UseStateForUnknown(),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Computed:    true,
+									Description: "Name of the machine_deployments topology entry",
+								},
+								"desired_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Desired worker machines, as set by Terraform or by the cluster autoscaler",
+								},
+								"replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines including those being provisioned or deleted",
+								},
+								"up_to_date_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines running the latest spec",
+								},
+								"ready_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines in the Ready state",
+								},
+								"available_replicas": schema.Int32Attribute{
+									Computed:    true,
+									Description: "Worker machines that have been ready for at least minReadySeconds",
+								},
+							},
+						},
+					},
 					"phase": schema.StringAttribute{
 						Computed:    true,
 						Description: "Current lifecycle phase of the cluster: Pending, Provisioning, Provisioned, Deleting, Failed, or Unknown",
@@ -0,0 +1,265 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vkscluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// vksClusterConditionCriterion is a condition that must reach the given status.
+type vksClusterConditionCriterion struct {
+	conditionType string
+	status        metav1.ConditionStatus
+}
+
+// vksClusterWaitCriteria holds everything that Create and Update wait for
+// after the API call, as configured in the wait_for attribute.
+type vksClusterWaitCriteria struct {
+	conditions      []vksClusterConditionCriterion
+	minReadyWorkers map[string]int32
+	failOnReasons   map[string]struct{}
+
+	// currentGeneration requires conditions to be observed for the current
+	// generation of the cluster, so that stale conditions reported before an
+	// update are not mistaken for the result of that update.
+	currentGeneration bool
+}
+
+func (c vksClusterWaitCriteria) isEmpty() bool {
+	return len(c.conditions) == 0 && len(c.minReadyWorkers) == 0
+}
+
+// addCondition registers a condition criterion unless one already exists for
+// the same condition type, in which case the existing one is kept.
+func (c *vksClusterWaitCriteria) addCondition(conditionType string, status metav1.ConditionStatus) {
+	for _, existing := range c.conditions {
+		if existing.conditionType == conditionType {
+			return
+		}
+	}
+	c.conditions = append(c.conditions, vksClusterConditionCriterion{conditionType: conditionType, status: status})
+}
+
+// extractWaitForCriteria converts the wait_for attribute into the criteria used by
+// waitForClusterReady. isUpdate enables the checks that only make sense after an
+// update (topology_reconciled and current generation tracking).
+func (r *vcfaVksClusterResource) extractWaitForCriteria(ctx context.Context, waitForObj types.Object, isUpdate bool) (vksClusterWaitCriteria, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	criteria := vksClusterWaitCriteria{currentGeneration: isUpdate}
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return criteria, diags
+	}
+	var wf vksClusterWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return criteria, diags
+	}
+
+	// Explicit conditions go first so that their expected status wins over the
+	// shorthand flags below.
+	if !wf.Conditions.IsNull() && !wf.Conditions.IsUnknown() {
+		var conditions []vksClusterWaitForConditionModel
+		diags.Append(wf.Conditions.ElementsAs(ctx, &conditions, false)...)
+		for _, c := range conditions {
+			status := metav1.ConditionTrue
+			if !c.Status.IsNull() && !c.Status.IsUnknown() {
+				status = metav1.ConditionStatus(c.Status.ValueString())
+			}
+			criteria.addCondition(c.Type.ValueString(), status)
+		}
+	}
+	if wf.Available.ValueBool() {
+		criteria.addCondition(vcfatypes.VksConditionAvailable, metav1.ConditionTrue)
+	}
+	if isUpdate && wf.TopologyReconciled.ValueBool() {
+		criteria.addCondition(vcfatypes.VksConditionTopologyReconciled, metav1.ConditionTrue)
+	}
+
+	if !wf.MinReadyWorkers.IsNull() && !wf.MinReadyWorkers.IsUnknown() {
+		criteria.minReadyWorkers = map[string]int32{}
+		diags.Append(wf.MinReadyWorkers.ElementsAs(ctx, &criteria.minReadyWorkers, false)...)
+	}
+
+	if !wf.FailOnReasons.IsNull() && !wf.FailOnReasons.IsUnknown() {
+		var reasons []string
+		diags.Append(wf.FailOnReasons.ElementsAs(ctx, &reasons, false)...)
+		criteria.failOnReasons = make(map[string]struct{}, len(reasons))
+		for _, reason := range reasons {
+			criteria.failOnReasons[reason] = struct{}{}
+		}
+	}
+
+	return criteria, diags
+}
+
+// waitForClusterReady polls the cluster until every criterion is met. It aborts
+// immediately when a condition that is waited on reports one of the fail-fast
+// reasons. On timeout, the latest state of every pending criterion is added to
+// diags as a warning to help troubleshooting.
+func (r *vcfaVksClusterResource) waitForClusterReady(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, timeout time.Duration, criteria vksClusterWaitCriteria, diags *diag.Diagnostics) error {
+	const (
+		vksClusterStateReady    = "Ready"
+		vksClusterStateNotReady = "NotReady"
+	)
+
+	var lastPending []string
+	conf := &retry.StateChangeConf{
+		Pending:      []string{vksClusterStateNotReady},
+		Target:       []string{vksClusterStateReady},
+		Timeout:      timeout,
+		PollInterval: vksClusterPollInterval,
+		Refresh: func() (any, string, error) {
+			var cluster vcfatypes.VksCluster
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVksClusterGVR(), &cluster); err != nil {
+				if apierrors.IsNotFound(err) {
+					return nil, "", fmt.Errorf("%s %s in VCF context %s/%s not found while waiting to become ready", vcfatypes.LabelVksCluster, name, projectName, namespace)
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to become ready: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
+			}
+
+			pending, err := pendingConditionCriteria(&cluster, criteria)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if len(criteria.minReadyWorkers) > 0 {
+				machineDeployments, err := listVksClusterMachineDeployments(ctx, k8sClient, namespace, name)
+				if err != nil {
+					return nil, "", fmt.Errorf("error polling %s of %s %s in VCF context %s/%s: %w", vcfatypes.LabelVksMachineDeployments, vcfatypes.LabelVksCluster, name, projectName, namespace, err)
+				}
+				pending = append(pending, pendingWorkerCriteria(machineDeployments, criteria.minReadyWorkers)...)
+			}
+
+			lastPending = pending
+			if len(pending) == 0 {
+				return &cluster, vksClusterStateReady, nil
+			}
+			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to become ready: %s", vcfatypes.LabelVksCluster, name, projectName, namespace, strings.Join(pending, "; "))
+			return &cluster, vksClusterStateNotReady, nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		var timeoutErr *retry.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			for _, p := range lastPending {
+				diags.AddWarning(fmt.Sprintf("%s %s is not ready yet", vcfatypes.LabelVksCluster, name), p)
+			}
+		}
+		return fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be ready: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
+	}
+	return nil
+}
+
+// pendingConditionCriteria returns a description of every condition criterion that
+// is not met yet, or an error when one of them reports a fail-fast reason.
+func pendingConditionCriteria(cluster *vcfatypes.VksCluster, criteria vksClusterWaitCriteria) ([]string, error) {
+	var pending []string
+	for _, c := range criteria.conditions {
+		condition := kubernetes.FindCondition(cluster.Status.Conditions, c.conditionType)
+		if condition == nil {
+			pending = append(pending, fmt.Sprintf("condition %s is not reported yet", c.conditionType))
+			continue
+		}
+		if criteria.currentGeneration && condition.ObservedGeneration != 0 && condition.ObservedGeneration < cluster.Generation {
+			pending = append(pending, fmt.Sprintf("condition %s was last observed for generation %d, waiting for generation %d", c.conditionType, condition.ObservedGeneration, cluster.Generation))
+			continue
+		}
+		if condition.Status == c.status {
+			continue
+		}
+		if _, ok := criteria.failOnReasons[condition.Reason]; ok {
+			return nil, fmt.Errorf("condition %s of %s %s reported the terminal reason %s: %s", c.conditionType, vcfatypes.LabelVksCluster, cluster.Name, condition.Reason, condition.Message)
+		}
+		pending = append(pending, fmt.Sprintf("condition %s is %s (expected %s) - reason: %s - message: %s", c.conditionType, condition.Status, c.status, condition.Reason, condition.Message))
+	}
+	return pending, nil
+}
+
+// pendingWorkerCriteria returns a description of every worker pool that has fewer
+// ready machines than requested in wait_for.min_ready_workers.
+func pendingWorkerCriteria(machineDeployments []vcfatypes.VksMachineDeployment, minReadyWorkers map[string]int32) []string {
+	ready := make(map[string]int32, len(machineDeployments))
+	for _, md := range machineDeployments {
+		pool := md.Labels[vcfatypes.VksTopologyMachineDeploymentNameLabel]
+		if md.Status.ReadyReplicas != nil {
+			ready[pool] += *md.Status.ReadyReplicas
+		}
+	}
+
+	pools := make([]string, 0, len(minReadyWorkers))
+	for pool := range minReadyWorkers {
+		pools = append(pools, pool)
+	}
+	sort.Strings(pools)
+
+	var pending []string
+	for _, pool := range pools {
+		if minimum := minReadyWorkers[pool]; ready[pool] < minimum {
+			pending = append(pending, fmt.Sprintf("worker pool %s has %d ready machines (expected at least %d)", pool, ready[pool], minimum))
+		}
+	}
+	return pending
+}
+
+func (r *vcfaVksClusterResource) extractWaitForDeleted(ctx context.Context, waitForObj types.Object) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if waitForObj.IsNull() || waitForObj.IsUnknown() {
+		return false, diags
+	}
+	var wf vksClusterWaitForModel
+	diags.Append(waitForObj.As(ctx, &wf, basetypes.ObjectAsOptions{})...)
+	if wf.Deleted.IsNull() || wf.Deleted.IsUnknown() {
+		return false, diags
+	}
+	return wf.Deleted.ValueBool(), diags
+}
+
+func (r *vcfaVksClusterResource) waitForClusterDeleted(ctx context.Context, k8sClient *kubernetes.Client, projectName string, namespace string, name string, deleteTimeout time.Duration) error {
+	const (
+		vksClusterStateExists  = "Exists"
+		vksClusterStateDeleted = "Deleted"
+	)
+
+	conf := &retry.StateChangeConf{
+		Pending:      []string{vksClusterStateExists},
+		Target:       []string{vksClusterStateDeleted},
+		Timeout:      deleteTimeout,
+		PollInterval: vksClusterPollInterval,
+		Refresh: func() (any, string, error) {
+			var cluster vcfatypes.VksCluster
+			if err := k8sClient.ReadNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVksClusterGVR(), &cluster); err != nil {
+				if apierrors.IsNotFound(err) {
+					return "", vksClusterStateDeleted, nil
+				}
+				return nil, "", fmt.Errorf("error polling %s %s in VCF context %s/%s while waiting to be deleted: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
+			}
+			log.Printf("[DEBUG] waiting for %s %s in VCF context %s/%s to be deleted (deletionTimestamp: %s - finalizers: %s)", vcfatypes.LabelVksCluster, name, projectName, namespace, cluster.DeletionTimestamp, cluster.Finalizers)
+			return &cluster, vksClusterStateExists, nil
+		},
+	}
+
+	if _, err := conf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in VCF context %s/%s to be deleted: %w", vcfatypes.LabelVksCluster, name, projectName, namespace, err)
+	}
+
+	return nil
+}
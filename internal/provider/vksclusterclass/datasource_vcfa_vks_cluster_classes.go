@@ -0,0 +1,96 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclusterclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ datasource.DataSource              = (*vcfaVksClusterClassesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*vcfaVksClusterClassesDataSource)(nil)
+)
+
+type vcfaVksClusterClassesDataSource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaVksClusterClassesDataSource() datasource.DataSource {
+	return &vcfaVksClusterClassesDataSource{}
+}
+
+func (d *vcfaVksClusterClassesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vks_cluster_classes"
+}
+
+func (d *vcfaVksClusterClassesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting TM client", err.Error())
+		return
+	}
+	d.tmClient = tmClient
+}
+
+func (d *vcfaVksClusterClassesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vcfaVksClusterClassesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, data.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	system := data.System.ValueBool()
+	labelSelector := data.LabelSelector.ValueString()
+
+	kubernetesClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s", vcfatypes.LabelVksClusterClasses),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(kubernetesClient.FlushWarnings()...) }()
+
+	clusterClassNamespace := namespace
+	if system {
+		clusterClassNamespace = vcfatypes.VksClusterClassSystemNamespace
+	}
+	var clusterClasses vcfatypes.VksClusterClassList
+	if err := kubernetesClient.ListNamespaceScopedResources(ctx, clusterClassNamespace, vcfatypes.GetVksClusterClassGVR(), labelSelector, &clusterClasses); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s in %s", vcfatypes.LabelVksClusterClasses, clusterClassNamespace),
+			fmt.Sprintf("Could not list %s in namespace %s for VCF context %s/%s: %s", vcfatypes.LabelVksClusterClasses, clusterClassNamespace, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, clusterClassNamespace))
+	data.System = types.BoolValue(system)
+	data.ClusterClasses = mapVksClusterClassListToSummaryModels(ctx, clusterClasses.Items, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
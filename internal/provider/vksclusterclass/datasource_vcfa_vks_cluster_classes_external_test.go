@@ -0,0 +1,75 @@
+//go:build vks || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclusterclass_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils/providertest"
+)
+
+// TestAccVcfaVksClusterClassesDatasourceExternal exercises the list path of the
+// vcfa_vks_cluster_classes data source against a live environment.
+func TestAccVcfaVksClusterClassesDatasourceExternal(t *testing.T) {
+	testutils.SkipIfSysAdmin(t)
+
+	cfg := testutils.GetTestConfig(t)
+
+	params := testutils.StringMap{
+		"Project":               cfg.Vks.Project,
+		"Namespace":             cfg.Vks.Namespace,
+		"ClusterClassName":      cfg.Vks.ClusterClassName,
+		"ClusterClassNamespace": cfg.Vks.ClusterClassNamespace,
+	}
+	testutils.TestParamsNotEmpty(t, params)
+
+	configText := testutils.TemplateFill(t, testAccVcfaVksClusterClassesDatasourceExternalConfig, params)
+	testutils.DebugPrintf("#[DEBUG] CONFIGURATION: %s\n", configText)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providertest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vcfa_vks_cluster_classes.all", "id"),
+					resource.TestCheckResourceAttr("data.vcfa_vks_cluster_classes.all", "system", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcfa_vks_cluster_classes.all", "cluster_classes.*", map[string]string{
+						"name":      params["ClusterClassName"].(string),
+						"namespace": params["ClusterClassNamespace"].(string),
+					}),
+					// A selector that matches nothing must return an empty list rather than an error
+					resource.TestCheckResourceAttr("data.vcfa_vks_cluster_classes.none", "cluster_classes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccVcfaVksClusterClassesDatasourceExternalConfig is the HCL template for the
+// vcfa_vks_cluster_classes data source.
+const testAccVcfaVksClusterClassesDatasourceExternalConfig = `
+data "vcfa_vks_cluster_classes" "all" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+  system = true
+}
+
+data "vcfa_vks_cluster_classes" "none" {
+  context = {
+    project   = "{{.Project}}"
+    namespace = "{{.Namespace}}"
+  }
+  system         = true
+  label_selector = "terraform-provider-vcfa/non-existent-label=true"
+}
+`
@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclusterclass
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// mapVksClusterClassListToSummaryModels converts the items of a ClusterClass list into the
+// summary entries exposed by the vcfa_vks_cluster_classes data source.
+func mapVksClusterClassListToSummaryModels(ctx context.Context, clusterClasses []vcfatypes.VksClusterClass, diags *diag.Diagnostics) []vksClusterClassSummaryModel {
+	summaries := make([]vksClusterClassSummaryModel, 0, len(clusterClasses))
+	for i := range clusterClasses {
+		summaries = append(summaries, mapVksClusterClassToSummaryModel(ctx, &clusterClasses[i], diags))
+	}
+	return summaries
+}
+
+func mapVksClusterClassToSummaryModel(ctx context.Context, clusterClass *vcfatypes.VksClusterClass, diags *diag.Diagnostics) vksClusterClassSummaryModel {
+	labels := clusterClass.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelMap, d := types.MapValueFrom(ctx, types.StringType, labels)
+	diags.Append(d...)
+
+	kubernetesVersions, d := types.ListValueFrom(ctx, types.StringType, clusterClass.Spec.KubernetesVersions)
+	diags.Append(d...)
+
+	workerClassNames := make([]string, 0, len(clusterClass.Spec.Workers.MachineDeployments))
+	for _, md := range clusterClass.Spec.Workers.MachineDeployments {
+		workerClassNames = append(workerClassNames, md.Class)
+	}
+	workerClasses, d := types.ListValueFrom(ctx, types.StringType, workerClassNames)
+	diags.Append(d...)
+
+	variableNames := make([]string, 0, len(clusterClass.Spec.Variables))
+	for _, v := range clusterClass.Spec.Variables {
+		variableNames = append(variableNames, v.Name)
+	}
+	variables, d := types.ListValueFrom(ctx, types.StringType, variableNames)
+	diags.Append(d...)
+
+	return vksClusterClassSummaryModel{
+		Name:               types.StringValue(clusterClass.Name),
+		Namespace:          types.StringValue(clusterClass.Namespace),
+		Labels:             labelMap,
+		KubernetesVersions: kubernetesVersions,
+		WorkerClasses:      workerClasses,
+		Variables:          variables,
+	}
+}
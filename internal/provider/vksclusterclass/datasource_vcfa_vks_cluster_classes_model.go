@@ -0,0 +1,31 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclusterclass
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ── DataSource Top-level model ───────────────────────────────────────────────
+
+type vcfaVksClusterClassesDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Context       types.Object `tfsdk:"context"`
+	System        types.Bool   `tfsdk:"system"`
+	LabelSelector types.String `tfsdk:"label_selector"`
+
+	ClusterClasses []vksClusterClassSummaryModel `tfsdk:"cluster_classes"`
+}
+
+// ── ClusterClass summary ─────────────────────────────────────────────────────
+
+type vksClusterClassSummaryModel struct {
+	Name               types.String `tfsdk:"name"`
+	Namespace          types.String `tfsdk:"namespace"`
+	Labels             types.Map    `tfsdk:"labels"`
+	KubernetesVersions types.List   `tfsdk:"kubernetes_versions"`
+	WorkerClasses      types.List   `tfsdk:"worker_classes"`
+	Variables          types.List   `tfsdk:"variables"`
+}
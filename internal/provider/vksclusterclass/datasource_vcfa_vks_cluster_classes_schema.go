@@ -0,0 +1,83 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclusterclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/validators"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (d *vcfaVksClusterClassesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Data source for listing the %s available to a VCF Automation context", vcfatypes.LabelVksClusterClasses),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal identifier of the listing",
+			},
+
+			// Lookup attributes
+			"context": common.VcfContextDataSourceSchema,
+			"system": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether to list the system-wide %s instead of the ones of the namespace", vcfatypes.LabelVksClusterClasses),
+			},
+			"label_selector": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Kubernetes label selector used to filter the %s (e.g. `env=prod,tier in (web,api)`)", vcfatypes.LabelVksClusterClasses),
+				Validators: []validator.String{
+					validators.IsValidLabelSelector(),
+				},
+			},
+
+			"cluster_classes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("Summary of every %s matching the lookup", vcfatypes.LabelVksClusterClass),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s", vcfatypes.LabelVksClusterClass),
+						},
+						"namespace": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Namespace of the %s", vcfatypes.LabelVksClusterClass),
+						},
+						"labels": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: fmt.Sprintf("Labels of the %s", vcfatypes.LabelVksClusterClass),
+						},
+						"kubernetes_versions": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Kubernetes versions supported by the ClusterClass",
+						},
+						"worker_classes": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Names of the MachineDeployment classes that can be used for worker pools",
+						},
+						"variables": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Names of the variables that can be set in clusters using this ClusterClass",
+						},
+					},
+				},
+			},
+		},
+	}
+}
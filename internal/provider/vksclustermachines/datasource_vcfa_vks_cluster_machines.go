@@ -0,0 +1,113 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclustermachines
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ datasource.DataSource              = (*vcfaVksClusterMachinesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*vcfaVksClusterMachinesDataSource)(nil)
+)
+
+type vcfaVksClusterMachinesDataSource struct {
+	tmClient *vcfa.VCDClient
+}
+
+func NewVcfaVksClusterMachinesDataSource() datasource.DataSource {
+	return &vcfaVksClusterMachinesDataSource{}
+}
+
+func (d *vcfaVksClusterMachinesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vks_cluster_machines"
+}
+
+func (d *vcfaVksClusterMachinesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting TM client", err.Error())
+		return
+	}
+	d.tmClient = tmClient
+}
+
+func (d *vcfaVksClusterMachinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vcfaVksClusterMachinesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcfContext := common.ExtractVcfContext(ctx, data.Context, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project := vcfContext.Project.ValueString()
+	namespace := vcfContext.Namespace.ValueString()
+	clusterName := data.ClusterName.ValueString()
+
+	kubernetesClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s", vcfatypes.LabelVksMachines),
+			fmt.Sprintf("error creating Kubernetes client for VCF context %s/%s: %s", project, namespace, err.Error()),
+		)
+		return
+	}
+	defer func() { resp.Diagnostics.Append(kubernetesClient.FlushWarnings()...) }()
+
+	labelSelector := ""
+	if clusterName != "" {
+		labelSelector = fmt.Sprintf("%s=%s", vcfatypes.VksClusterNameLabel, clusterName)
+	}
+
+	var machines vcfatypes.VksMachineList
+	if err := kubernetesClient.ListNamespaceScopedResources(ctx, namespace, vcfatypes.GetVksMachineGVR(), labelSelector, &machines); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s", vcfatypes.LabelVksMachines),
+			fmt.Sprintf("could not list %s in VCF context %s/%s: %s", vcfatypes.LabelVksMachines, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	var machineSets vcfatypes.VksMachineSetList
+	if err := kubernetesClient.ListNamespaceScopedResources(ctx, namespace, vcfatypes.GetVksMachineSetGVR(), labelSelector, &machineSets); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s", vcfatypes.LabelVksMachineSets),
+			fmt.Sprintf("could not list %s in VCF context %s/%s: %s", vcfatypes.LabelVksMachineSets, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	var machineDeployments vcfatypes.VksMachineDeploymentList
+	if err := kubernetesClient.ListNamespaceScopedResources(ctx, namespace, vcfatypes.GetVksMachineDeploymentGVR(), labelSelector, &machineDeployments); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("error listing %s", vcfatypes.LabelVksMachineDeployments),
+			fmt.Sprintf("could not list %s in VCF context %s/%s: %s", vcfatypes.LabelVksMachineDeployments, project, namespace, err.Error()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, clusterName))
+	mapVksClusterMachinesToModel(ctx, machines.Items, machineSets.Items, machineDeployments.Items, &data, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclustermachines
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func mapVksClusterMachinesToModel(ctx context.Context, machines []vcfatypes.VksMachine, machineSets []vcfatypes.VksMachineSet, machineDeployments []vcfatypes.VksMachineDeployment, model *vcfaVksClusterMachinesModel, diags *diag.Diagnostics) {
+	model.Machines = make([]vksMachineModel, 0, len(machines))
+	for _, m := range machines {
+		model.Machines = append(model.Machines, mapVksMachineToModel(ctx, m, diags))
+	}
+
+	model.MachineSets = make([]vksMachineSetModel, 0, len(machineSets))
+	for _, ms := range machineSets {
+		model.MachineSets = append(model.MachineSets, vksMachineSetModel{
+			Name:              types.StringValue(ms.Name),
+			ClusterName:       types.StringValue(ms.Spec.ClusterName),
+			MachineDeployment: helpers.StringOrNull(ms.Labels[vcfatypes.VksMachineDeploymentNameLabel]),
+			Replicas:          types.Int32PointerValue(ms.Status.Replicas),
+			ReadyReplicas:     types.Int32PointerValue(ms.Status.ReadyReplicas),
+			AvailableReplicas: types.Int32PointerValue(ms.Status.AvailableReplicas),
+			UpToDateReplicas:  types.Int32PointerValue(ms.Status.UpToDateReplicas),
+			Conditions:        kubernetes.MapConditionsToModel(ctx, ms.Status.Conditions, diags),
+		})
+	}
+
+	model.MachineDeployments = make([]vksMachineDeploymentModel, 0, len(machineDeployments))
+	for _, md := range machineDeployments {
+		model.MachineDeployments = append(model.MachineDeployments, vksMachineDeploymentModel{
+			Name:              types.StringValue(md.Name),
+			ClusterName:       types.StringValue(md.Spec.ClusterName),
+			Pool:              helpers.StringOrNull(md.Labels[vcfatypes.VksTopologyMachineDeploymentNameLabel]),
+			Phase:             types.StringValue(md.Status.Phase),
+			DesiredReplicas:   types.Int32PointerValue(md.Spec.Replicas),
+			Replicas:          types.Int32PointerValue(md.Status.Replicas),
+			ReadyReplicas:     types.Int32PointerValue(md.Status.ReadyReplicas),
+			AvailableReplicas: types.Int32PointerValue(md.Status.AvailableReplicas),
+			UpToDateReplicas:  types.Int32PointerValue(md.Status.UpToDateReplicas),
+			Conditions:        kubernetes.MapConditionsToModel(ctx, md.Status.Conditions, diags),
+		})
+	}
+}
+
+func mapVksMachineToModel(ctx context.Context, machine vcfatypes.VksMachine, diags *diag.Diagnostics) vksMachineModel {
+	addresses := make([]vksMachineAddressModel, 0, len(machine.Status.Addresses))
+	for _, a := range machine.Status.Addresses {
+		addresses = append(addresses, vksMachineAddressModel{
+			Type:    types.StringValue(string(a.Type)),
+			Address: types.StringValue(a.Address),
+		})
+	}
+
+	// Machines created from a cluster topology carry the name of their
+	// machine_deployments entry; control plane machines have no pool.
+	_, controlPlane := machine.Labels[vcfatypes.VksMachineControlPlaneLabel]
+
+	// The failure domain is reported in the status once the machine is placed.
+	failureDomain := machine.Status.FailureDomain
+	if failureDomain == "" {
+		failureDomain = machine.Spec.FailureDomain
+	}
+
+	return vksMachineModel{
+		Name:              types.StringValue(machine.Name),
+		ClusterName:       types.StringValue(machine.Spec.ClusterName),
+		Pool:              helpers.StringOrNull(machine.Labels[vcfatypes.VksTopologyMachineDeploymentNameLabel]),
+		ControlPlane:      types.BoolValue(controlPlane),
+		MachineSet:        helpers.StringOrNull(machine.Labels[vcfatypes.VksMachineSetNameLabel]),
+		MachineDeployment: helpers.StringOrNull(machine.Labels[vcfatypes.VksMachineDeploymentNameLabel]),
+		Phase:             types.StringValue(machine.Status.Phase),
+		ProviderID:        helpers.StringOrNull(machine.Spec.ProviderID),
+		NodeName:          helpers.StringOrNull(machine.Status.NodeRef.Name),
+		Addresses:         addresses,
+		Version:           helpers.StringOrNull(machine.Spec.Version),
+		FailureDomain:     helpers.StringOrNull(failureDomain),
+		Conditions:        kubernetes.MapConditionsToModel(ctx, machine.Status.Conditions, diags),
+	}
+}
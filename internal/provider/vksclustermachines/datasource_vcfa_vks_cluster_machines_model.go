@@ -0,0 +1,74 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclustermachines
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+)
+
+// ── Top-level model ──────────────────────────────────────────────────────────
+
+type vcfaVksClusterMachinesModel struct {
+	ID          types.String `tfsdk:"id"`
+	Context     types.Object `tfsdk:"context"`
+	ClusterName types.String `tfsdk:"cluster_name"`
+
+	Machines           []vksMachineModel           `tfsdk:"machines"`
+	MachineSets        []vksMachineSetModel        `tfsdk:"machine_sets"`
+	MachineDeployments []vksMachineDeploymentModel `tfsdk:"machine_deployments"`
+}
+
+// ── Machine ──────────────────────────────────────────────────────────────────
+
+type vksMachineModel struct {
+	Name              types.String               `tfsdk:"name"`
+	ClusterName       types.String               `tfsdk:"cluster_name"`
+	Pool              types.String               `tfsdk:"pool"`
+	ControlPlane      types.Bool                 `tfsdk:"control_plane"`
+	MachineSet        types.String               `tfsdk:"machine_set"`
+	MachineDeployment types.String               `tfsdk:"machine_deployment"`
+	Phase             types.String               `tfsdk:"phase"`
+	ProviderID        types.String               `tfsdk:"provider_id"`
+	NodeName          types.String               `tfsdk:"node_name"`
+	Addresses         []vksMachineAddressModel   `tfsdk:"addresses"`
+	Version           types.String               `tfsdk:"version"`
+	FailureDomain     types.String               `tfsdk:"failure_domain"`
+	Conditions        kubernetes.ConditionsModel `tfsdk:"conditions"`
+}
+
+type vksMachineAddressModel struct {
+	Type    types.String `tfsdk:"type"`
+	Address types.String `tfsdk:"address"`
+}
+
+// ── MachineSet ───────────────────────────────────────────────────────────────
+
+type vksMachineSetModel struct {
+	Name              types.String               `tfsdk:"name"`
+	ClusterName       types.String               `tfsdk:"cluster_name"`
+	MachineDeployment types.String               `tfsdk:"machine_deployment"`
+	Replicas          types.Int32                `tfsdk:"replicas"`
+	ReadyReplicas     types.Int32                `tfsdk:"ready_replicas"`
+	AvailableReplicas types.Int32                `tfsdk:"available_replicas"`
+	UpToDateReplicas  types.Int32                `tfsdk:"up_to_date_replicas"`
+	Conditions        kubernetes.ConditionsModel `tfsdk:"conditions"`
+}
+
+// ── MachineDeployment ────────────────────────────────────────────────────────
+
+type vksMachineDeploymentModel struct {
+	Name              types.String               `tfsdk:"name"`
+	ClusterName       types.String               `tfsdk:"cluster_name"`
+	Pool              types.String               `tfsdk:"pool"`
+	Phase             types.String               `tfsdk:"phase"`
+	DesiredReplicas   types.Int32                `tfsdk:"desired_replicas"`
+	Replicas          types.Int32                `tfsdk:"replicas"`
+	ReadyReplicas     types.Int32                `tfsdk:"ready_replicas"`
+	AvailableReplicas types.Int32                `tfsdk:"available_replicas"`
+	UpToDateReplicas  types.Int32                `tfsdk:"up_to_date_replicas"`
+	Conditions        kubernetes.ConditionsModel `tfsdk:"conditions"`
+}
@@ -0,0 +1,188 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vksclustermachines
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/common"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubernetes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func (d *vcfaVksClusterMachinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Data source for listing the %s, %s and %s of a VCF Automation context", vcfatypes.LabelVksMachines, vcfatypes.LabelVksMachineSets, vcfatypes.LabelVksMachineDeployments),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Internal identifier of the listing",
+			},
+
+			// Lookup attributes
+			"context": common.VcfContextDataSourceSchema,
+			"cluster_name": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Name of the %s whose machines are listed. All the machines of the namespace are returned when omitted", vcfatypes.LabelVksCluster),
+			},
+
+			"machines": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("%s matching the lookup", vcfatypes.LabelVksMachines),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s", vcfatypes.LabelVksMachine),
+						},
+						"cluster_name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s the machine belongs to", vcfatypes.LabelVksCluster),
+						},
+						"pool": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the MachineDeployment topology entry (worker pool) of the machine. Null for control plane machines",
+						},
+						"control_plane": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the machine is part of the control plane",
+						},
+						"machine_set": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s that owns the machine", vcfatypes.LabelVksMachineSet),
+						},
+						"machine_deployment": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s that owns the machine", vcfatypes.LabelVksMachineDeployment),
+						},
+						"phase": schema.StringAttribute{
+							Computed:    true,
+							Description: "Current lifecycle phase of the machine",
+						},
+						"provider_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Identifier of the machine given by the infrastructure provider",
+						},
+						"node_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Kubernetes node backed by the machine",
+						},
+						"addresses": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Addresses assigned to the machine",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:    true,
+										Description: "Address type, such as `InternalIP`, `ExternalIP` or `Hostname`",
+									},
+									"address": schema.StringAttribute{
+										Computed:    true,
+										Description: "Value of the address",
+									},
+								},
+							},
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kubernetes version of the machine",
+						},
+						"failure_domain": schema.StringAttribute{
+							Computed:    true,
+							Description: "Failure domain where the machine is placed",
+						},
+						"conditions": kubernetes.ConditionsDataSourceSchema,
+					},
+				},
+			},
+			"machine_sets": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("%s matching the lookup", vcfatypes.LabelVksMachineSets),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s", vcfatypes.LabelVksMachineSet),
+						},
+						"cluster_name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s the MachineSet belongs to", vcfatypes.LabelVksCluster),
+						},
+						"machine_deployment": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s that owns the MachineSet", vcfatypes.LabelVksMachineDeployment),
+						},
+						"replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of machines owned by the MachineSet",
+						},
+						"ready_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of ready machines owned by the MachineSet",
+						},
+						"available_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of available machines owned by the MachineSet",
+						},
+						"up_to_date_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of up-to-date machines owned by the MachineSet",
+						},
+						"conditions": kubernetes.ConditionsDataSourceSchema,
+					},
+				},
+			},
+			"machine_deployments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: fmt.Sprintf("%s matching the lookup", vcfatypes.LabelVksMachineDeployments),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s", vcfatypes.LabelVksMachineDeployment),
+						},
+						"cluster_name": schema.StringAttribute{
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s the MachineDeployment belongs to", vcfatypes.LabelVksCluster),
+						},
+						"pool": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the MachineDeployment topology entry (worker pool)",
+						},
+						"phase": schema.StringAttribute{
+							Computed:    true,
+							Description: "Current lifecycle phase of the MachineDeployment",
+						},
+						"desired_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Desired number of machines",
+						},
+						"replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of machines owned by the MachineDeployment",
+						},
+						"ready_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of ready machines",
+						},
+						"available_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of available machines",
+						},
+						"up_to_date_replicas": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Number of up-to-date machines",
+						},
+						"conditions": kubernetes.ConditionsDataSourceSchema,
+					},
+				},
+			},
+		},
+	}
+}
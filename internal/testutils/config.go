@@ -72,6 +72,13 @@ type TestConfig struct {
 		ControlPlaneReplicas  string `json:"controlPlaneReplicas"`
 		WorkerReplicas        string `json:"workerReplicas"`
 	} `json:"vks"`
+	VmService struct {
+		Project      string `json:"project"`
+		Namespace    string `json:"namespace"`
+		VmClass      string `json:"vmClass"`
+		StorageClass string `json:"storageClass"`
+		Image        string `json:"image"`
+	} `json:"vmService"`
 	Tm struct {
 		Org             string   `json:"org"`
 		CreateRegion    bool     `json:"createRegion"`
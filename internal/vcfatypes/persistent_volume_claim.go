@@ -0,0 +1,38 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PersistentVolumeClaim is an alias for the Kubernetes core v1 PersistentVolumeClaim type
+type PersistentVolumeClaim = corev1.PersistentVolumeClaim
+
+// PersistentVolumeClaimList is an alias for the Kubernetes core v1 PersistentVolumeClaimList type
+type PersistentVolumeClaimList = corev1.PersistentVolumeClaimList
+
+// Constants for Kubernetes core resource types and versions
+const (
+	CoreVersion                   = "v1"
+	PersistentVolumeClaimKind     = "PersistentVolumeClaim"
+	PersistentVolumeClaimResource = "persistentvolumeclaims"
+)
+
+// Labels for logging and error messages
+const (
+	LabelPersistentVolumeClaim  = "Persistent Volume Claim"
+	LabelPersistentVolumeClaims = "Persistent Volume Claims"
+)
+
+// GetPersistentVolumeClaimGVR returns the GroupVersionResource for Kubernetes core v1 PersistentVolumeClaim
+func GetPersistentVolumeClaimGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "",
+		Version:  CoreVersion,
+		Resource: PersistentVolumeClaimResource,
+	}
+}
@@ -0,0 +1,61 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProjectRoleBinding grants a Project role to a user or a group of the Organization. Its name
+// identifies the principal, and is built with ProjectRoleBindingName.
+type ProjectRoleBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	RoleRef  rbacv1.RoleRef   `json:"roleRef"`
+	Subjects []rbacv1.Subject `json:"subjects,omitempty"`
+}
+
+const (
+	// Roles that can be granted on a Project
+	ProjectRoleAdmin = "admin"
+	ProjectRoleEdit  = "edit"
+	ProjectRoleView  = "view"
+
+	// ProjectRoleBindingNamePrefix is the prefix of the names of the ProjectRoleBindings, which are
+	// followed by the principal type and name (e.g. cci:user:jdoe)
+	ProjectRoleBindingNamePrefix = "cci"
+)
+
+// Constants for the CCI resource types and versions of Projects
+const (
+	AuthorizationGroup           = "authorization.cci.vmware.com"
+	AuthorizationVersion         = "v1alpha2"
+	ProjectRoleKind              = "ProjectRole"
+	ProjectRoleBindingKind       = "ProjectRoleBinding"
+	ProjectRoleBindingAPIVersion = AuthorizationGroup + "/" + AuthorizationVersion
+	ProjectRoleBindingsURL       = "/apis/" + ProjectRoleBindingAPIVersion + "/namespaces/%s/projectrolebindings"
+)
+
+// ProjectRoleBindingSubjectKinds maps the principal types to the kinds of the subjects of a
+// ProjectRoleBinding
+var ProjectRoleBindingSubjectKinds = map[string]string{
+	PrincipalTypeUser:  rbacv1.UserKind,
+	PrincipalTypeGroup: rbacv1.GroupKind,
+}
+
+// Labels for logging and error messages
+const (
+	LabelProject             = "Project"
+	LabelProjects            = "Projects"
+	LabelProjectRoleBinding  = "Project Role Binding"
+	LabelProjectRoleBindings = "Project Role Bindings"
+)
+
+// ProjectRoleBindingName returns the name of the ProjectRoleBinding of the given principal
+func ProjectRoleBindingName(principalType, principalName string) string {
+	return ProjectRoleBindingNamePrefix + ":" + principalType + ":" + principalName
+}
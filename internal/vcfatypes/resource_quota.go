@@ -0,0 +1,39 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ResourceQuota is an alias for the Kubernetes core v1 ResourceQuota type
+type ResourceQuota = corev1.ResourceQuota
+
+// ResourceQuotaList is an alias for the Kubernetes core v1 ResourceQuotaList type
+type ResourceQuotaList = corev1.ResourceQuotaList
+
+// Constants for Kubernetes core resource types
+const (
+	ResourceQuotaResource = "resourcequotas"
+
+	// StorageClassQuotaSuffix is appended to the name of a storage class to build the
+	// ResourceQuota key tracking the storage requested from that class
+	StorageClassQuotaSuffix = ".storageclass.storage.k8s.io/requests.storage"
+)
+
+// Labels for logging and error messages
+const (
+	LabelResourceQuotas = "Resource Quotas"
+)
+
+// GetResourceQuotaGVR returns the GroupVersionResource for Kubernetes core v1 ResourceQuota
+func GetResourceQuotaGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "",
+		Version:  CoreVersion,
+		Resource: ResourceQuotaResource,
+	}
+}
@@ -0,0 +1,87 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RoleBinding is an alias for the Kubernetes RBAC v1 RoleBinding type
+type RoleBinding = rbacv1.RoleBinding
+
+// RoleBindingList is an alias for the Kubernetes RBAC v1 RoleBindingList type
+type RoleBindingList = rbacv1.RoleBindingList
+
+const (
+	// Access levels that can be granted on a Supervisor Namespace
+	SupervisorNamespaceRoleView  = "view"
+	SupervisorNamespaceRoleEdit  = "edit"
+	SupervisorNamespaceRoleOwner = "owner"
+
+	// Kinds of principals that can be granted access to a Supervisor Namespace
+	PrincipalTypeUser  = "user"
+	PrincipalTypeGroup = "group"
+
+	// ManagedByLabel is set on the Kubernetes objects created by the provider, with
+	// ManagedByLabelValue as value
+	ManagedByLabel      = "app.kubernetes.io/managed-by"
+	ManagedByLabelValue = "terraform-provider-vcfa"
+)
+
+// systemLabelDomains are the domains of the labels that VCFA and the Supervisor set on the
+// Kubernetes objects that they manage
+var systemLabelDomains = []string{"vmware.com", "vmware-system"}
+
+// IsSystemLabel returns true if the given label key belongs to VCFA or the Supervisor, i.e.
+// "vmware-system-user-access" or "iaas.vmware.com/owner". The prefix of the key must be one of
+// the system domains or one of their subdomains, so "vmware.community/owner" is not a system label.
+// Keys without prefix must be one of the system domains, optionally followed by a dash.
+func IsSystemLabel(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	for _, domain := range systemLabelDomains {
+		if found && (prefix == domain || strings.HasSuffix(prefix, "."+domain)) {
+			return true
+		}
+		if !found && (key == domain || strings.HasPrefix(key, domain+"-")) {
+			return true
+		}
+	}
+	return false
+}
+
+// SupervisorNamespaceRoleClusterRoles maps the access levels of a Supervisor Namespace to the
+// ClusterRoles that are bound to grant them
+var SupervisorNamespaceRoleClusterRoles = map[string]string{
+	SupervisorNamespaceRoleView:  "view",
+	SupervisorNamespaceRoleEdit:  "edit",
+	SupervisorNamespaceRoleOwner: "admin",
+}
+
+// Constants for Kubernetes RBAC resource types and versions
+const (
+	RbacGroup             = rbacv1.GroupName
+	RbacVersion           = "v1"
+	RoleBindingKind       = "RoleBinding"
+	RoleBindingResource   = "rolebindings"
+	RoleBindingAPIVersion = RbacGroup + "/" + RbacVersion
+)
+
+// Labels for logging and error messages
+const (
+	LabelSupervisorNamespaceRoleBinding  = "Supervisor Namespace Role Binding"
+	LabelSupervisorNamespaceRoleBindings = "Supervisor Namespace Role Bindings"
+)
+
+// GetRoleBindingGVR returns the GroupVersionResource for Kubernetes RBAC v1 RoleBinding
+func GetRoleBindingGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    RbacGroup,
+		Version:  RbacVersion,
+		Resource: RoleBindingResource,
+	}
+}
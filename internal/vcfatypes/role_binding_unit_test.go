@@ -0,0 +1,40 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import "testing"
+
+func TestIsSystemLabel(t *testing.T) {
+	type testCase struct {
+		key      string
+		expected bool
+	}
+
+	testCases := []testCase{
+		{key: "vmware-system-user-access", expected: true},
+		{key: "vmware-system", expected: true},
+		{key: "vmware.com/owner", expected: true},
+		{key: "iaas.vmware.com/owner", expected: true},
+		{key: "vmware-system/owner", expected: true},
+		{key: "vmware.community/owner"},
+		{key: "vmware.com.example.org/owner"},
+		{key: "notvmware.com/owner"},
+		{key: "vmware-systematic"},
+		{key: "vmware-systematic/owner"},
+		{key: "example.com/vmware-system-user-access"},
+		{key: "app.kubernetes.io/managed-by"},
+		{key: "owner"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.key, func(t *testing.T) {
+			if got := IsSystemLabel(tc.key); got != tc.expected {
+				t.Errorf("expected IsSystemLabel(%q) to be %t, got %t", tc.key, tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Secret is an alias for the Kubernetes core v1 Secret type
+type Secret = corev1.Secret
+
+const (
+	// Types of Secret that can be managed in a Supervisor Namespace
+	SecretTypeOpaque           = string(corev1.SecretTypeOpaque)
+	SecretTypeDockerConfigJson = string(corev1.SecretTypeDockerConfigJson)
+	SecretTypeTLS              = string(corev1.SecretTypeTLS)
+
+	SecretKind = "Secret"
+)
+
+// SecretTypeRequiredKeys lists the data keys that each type of Secret must contain
+var SecretTypeRequiredKeys = map[string][]string{
+	SecretTypeOpaque:           nil,
+	SecretTypeDockerConfigJson: {corev1.DockerConfigJsonKey},
+	SecretTypeTLS:              {corev1.TLSCertKey, corev1.TLSPrivateKeyKey},
+}
+
+// Labels for logging and error messages
+const (
+	LabelNamespaceSecret  = "Namespace Secret"
+	LabelNamespaceSecrets = "Namespace Secrets"
+)
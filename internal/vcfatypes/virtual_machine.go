@@ -0,0 +1,186 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VirtualMachine is the schema for the VM Operator virtualmachines API. It represents
+// the desired specification and the observed status of a VM Service virtual machine
+// running in a Supervisor Namespace.
+type VirtualMachine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSpec   `json:"spec,omitempty"`
+	Status VirtualMachineStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineList contains a list of VirtualMachine
+type VirtualMachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachine `json:"items"`
+}
+
+// VirtualMachineSpec defines the desired state of a VirtualMachine
+type VirtualMachineSpec struct {
+	// Image is a reference to the VirtualMachineImage or ClusterVirtualMachineImage used to
+	// deploy the virtual machine.
+	Image *VirtualMachineImageRef `json:"image,omitempty"`
+
+	// ImageName is the name of the image used to deploy the virtual machine. It accepts the
+	// name of a VirtualMachineImage or ClusterVirtualMachineImage resource.
+	ImageName string `json:"imageName,omitempty"`
+
+	// ClassName is the name of the VirtualMachineClass that describes the virtual hardware
+	// settings of the virtual machine.
+	ClassName string `json:"className,omitempty"`
+
+	// StorageClass is the name of the StorageClass used to place the virtual machine disks.
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Bootstrap describes the guest customization applied to the virtual machine.
+	Bootstrap *VirtualMachineBootstrapSpec `json:"bootstrap,omitempty"`
+
+	// Network describes the network interfaces of the virtual machine.
+	Network *VirtualMachineNetworkSpec `json:"network,omitempty"`
+
+	// PowerState is the desired power state of the virtual machine.
+	PowerState string `json:"powerState,omitempty"`
+}
+
+// VirtualMachineImageRef refers to a VirtualMachineImage or ClusterVirtualMachineImage
+type VirtualMachineImageRef struct {
+	// Kind is either VirtualMachineImage or ClusterVirtualMachineImage.
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the referenced image.
+	Name string `json:"name"`
+}
+
+// VirtualMachineBootstrapSpec defines the bootstrap provider used to customize the guest.
+// Only one of the providers may be set.
+type VirtualMachineBootstrapSpec struct {
+	CloudInit *VirtualMachineBootstrapCloudInitSpec `json:"cloudInit,omitempty"`
+	Sysprep   *VirtualMachineBootstrapSysprepSpec   `json:"sysprep,omitempty"`
+}
+
+// VirtualMachineBootstrapCloudInitSpec describes the cloud-init configuration of the guest
+type VirtualMachineBootstrapCloudInitSpec struct {
+	// RawCloudConfig refers to the Secret key holding the cloud-init user data.
+	RawCloudConfig *SecretKeySelector `json:"rawCloudConfig,omitempty"`
+}
+
+// VirtualMachineBootstrapSysprepSpec describes the Sysprep configuration of a Windows guest
+type VirtualMachineBootstrapSysprepSpec struct {
+	// RawSysprep refers to the Secret key holding the Sysprep unattend XML.
+	RawSysprep *SecretKeySelector `json:"rawSysprep,omitempty"`
+}
+
+// SecretKeySelector selects a key of a Secret in the namespace of the referring object
+type SecretKeySelector struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// VirtualMachineNetworkSpec defines the network configuration of a VirtualMachine
+type VirtualMachineNetworkSpec struct {
+	// Interfaces is the list of network interfaces of the virtual machine. When empty, a
+	// single interface attached to the default network of the namespace is created.
+	Interfaces []VirtualMachineNetworkInterfaceSpec `json:"interfaces,omitempty"`
+}
+
+// VirtualMachineNetworkInterfaceSpec describes a network interface of a VirtualMachine
+type VirtualMachineNetworkInterfaceSpec struct {
+	// Name is the name of the interface inside the guest (e.g. eth0).
+	Name string `json:"name"`
+
+	// Network is the network the interface is attached to. The default network of the
+	// namespace is used when omitted.
+	Network *PartialObjectRef `json:"network,omitempty"`
+}
+
+// PartialObjectRef is a reference to an object of a given kind and API version
+type PartialObjectRef struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Name string `json:"name"`
+}
+
+// VirtualMachineStatus defines the observed state of a VirtualMachine
+type VirtualMachineStatus struct {
+	// PowerState is the observed power state of the virtual machine.
+	PowerState string `json:"powerState,omitempty"`
+
+	// Conditions describes the observed conditions of the virtual machine.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Network describes the observed network configuration of the virtual machine.
+	Network *VirtualMachineNetworkStatus `json:"network,omitempty"`
+
+	// UniqueID is the managed object reference of the virtual machine in vSphere.
+	UniqueID string `json:"uniqueID,omitempty"`
+
+	// BiosUUID is the BIOS UUID of the virtual machine.
+	BiosUUID string `json:"biosUUID,omitempty"`
+
+	// InstanceUUID is the instance UUID of the virtual machine.
+	InstanceUUID string `json:"instanceUUID,omitempty"`
+
+	// Zone is the name of the zone where the virtual machine is placed.
+	Zone string `json:"zone,omitempty"`
+}
+
+// VirtualMachineNetworkStatus defines the observed network state of a VirtualMachine
+type VirtualMachineNetworkStatus struct {
+	// PrimaryIP4 is the primary IPv4 address assigned to the guest.
+	PrimaryIP4 string `json:"primaryIP4,omitempty"`
+
+	// PrimaryIP6 is the primary IPv6 address assigned to the guest.
+	PrimaryIP6 string `json:"primaryIP6,omitempty"`
+}
+
+const (
+	// Desired and observed power states of a VirtualMachine
+	VirtualMachinePowerStateOn        = "PoweredOn"
+	VirtualMachinePowerStateOff       = "PoweredOff"
+	VirtualMachinePowerStateSuspended = "Suspended"
+
+	// Kinds of images a VirtualMachine can be deployed from
+	VirtualMachineImageKind        = "VirtualMachineImage"
+	ClusterVirtualMachineImageKind = "ClusterVirtualMachineImage"
+
+	// Default Secret keys holding the bootstrap data of a VirtualMachine
+	VirtualMachineCloudInitDefaultKey = "user-data"
+	VirtualMachineSysprepDefaultKey   = "unattend"
+)
+
+// Constants for VM Operator resource types and versions
+const (
+	VmOperatorGroup          = "vmoperator.vmware.com"
+	VmOperatorVersion        = "v1alpha3"
+	VirtualMachineKind       = "VirtualMachine"
+	VirtualMachineResource   = "virtualmachines"
+	VirtualMachineAPIVersion = VmOperatorGroup + "/" + VmOperatorVersion
+)
+
+// Labels for logging and error messages
+const (
+	LabelVirtualMachine  = "Virtual Machine"
+	LabelVirtualMachines = "Virtual Machines"
+)
+
+// GetVirtualMachineGVR returns the GroupVersionResource for VM Operator VirtualMachine
+func GetVirtualMachineGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    VmOperatorGroup,
+		Version:  VmOperatorVersion,
+		Resource: VirtualMachineResource,
+	}
+}
@@ -0,0 +1,86 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VirtualMachineClass is the schema for the VM Operator virtualmachineclasses API. It describes
+// the virtual hardware and the resource policies of the virtual machines that use it.
+type VirtualMachineClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineClassSpec `json:"spec,omitempty"`
+}
+
+// VirtualMachineClassList contains a list of VirtualMachineClass
+type VirtualMachineClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VirtualMachineClass `json:"items"`
+}
+
+// VirtualMachineClassSpec defines the desired state of a VirtualMachineClass
+type VirtualMachineClassSpec struct {
+	// Hardware describes the virtual hardware of the virtual machines using the class.
+	Hardware VirtualMachineClassHardware `json:"hardware,omitempty"`
+
+	// Policies describes the resource reservations and limits of the virtual machines
+	// using the class.
+	Policies VirtualMachineClassPolicies `json:"policies,omitempty"`
+}
+
+// VirtualMachineClassHardware describes the virtual hardware of a VirtualMachineClass
+type VirtualMachineClassHardware struct {
+	// Cpus is the number of virtual CPUs.
+	Cpus int64 `json:"cpus,omitempty"`
+
+	// Memory is the amount of memory.
+	Memory resource.Quantity `json:"memory,omitempty"`
+}
+
+// VirtualMachineClassPolicies describes the resource policies of a VirtualMachineClass
+type VirtualMachineClassPolicies struct {
+	Resources VirtualMachineClassResources `json:"resources,omitempty"`
+}
+
+// VirtualMachineClassResources describes the resource reservations (requests) and limits
+// of a VirtualMachineClass
+type VirtualMachineClassResources struct {
+	Requests VirtualMachineResourceSpec `json:"requests,omitempty"`
+	Limits   VirtualMachineResourceSpec `json:"limits,omitempty"`
+}
+
+// VirtualMachineResourceSpec describes an amount of CPU, expressed in Hz, and memory
+type VirtualMachineResourceSpec struct {
+	Cpu    resource.Quantity `json:"cpu,omitempty"`
+	Memory resource.Quantity `json:"memory,omitempty"`
+}
+
+// Constants for VM Operator resource types
+const (
+	VirtualMachineClassKind     = "VirtualMachineClass"
+	VirtualMachineClassResource = "virtualmachineclasses"
+)
+
+// Labels for logging and error messages
+const (
+	LabelVirtualMachineClass   = "Virtual Machine Class"
+	LabelVirtualMachineClasses = "Virtual Machine Classes"
+)
+
+// GetVirtualMachineClassGVR returns the GroupVersionResource for VM Operator VirtualMachineClass
+func GetVirtualMachineClassGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    VmOperatorGroup,
+		Version:  VmOperatorVersion,
+		Resource: VirtualMachineClassResource,
+	}
+}
@@ -0,0 +1,99 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// VirtualMachineService is the schema for the VM Operator virtualmachineservices API. It
+// exposes the VirtualMachines matching its selector, typically through a load balancer.
+type VirtualMachineService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineServiceSpec   `json:"spec,omitempty"`
+	Status VirtualMachineServiceStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineServiceSpec defines the desired state of a VirtualMachineService
+type VirtualMachineServiceSpec struct {
+	// Type determines how the VirtualMachineService is exposed: ClusterIP or LoadBalancer.
+	Type string `json:"type"`
+
+	// Ports specifies the list of ports exposed by the service.
+	Ports []VirtualMachineServicePort `json:"ports,omitempty"`
+
+	// Selector selects the VirtualMachines the traffic is routed to by their labels.
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// LoadBalancerSourceRanges restricts the client IP ranges allowed to reach the
+	// load balancer. All ranges are allowed when empty.
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+}
+
+// VirtualMachineServicePort describes a port exposed by a VirtualMachineService
+type VirtualMachineServicePort struct {
+	// Name of the port. Must be unique within the service.
+	Name string `json:"name"`
+
+	// Protocol of the port: TCP, UDP or SCTP.
+	Protocol string `json:"protocol"`
+
+	// Port exposed by the service.
+	Port int32 `json:"port"`
+
+	// TargetPort is the port the traffic is forwarded to on the VirtualMachines.
+	TargetPort int32 `json:"targetPort"`
+}
+
+// VirtualMachineServiceStatus defines the observed state of a VirtualMachineService
+type VirtualMachineServiceStatus struct {
+	// LoadBalancer contains the current status of the load balancer, if one is present.
+	LoadBalancer VirtualMachineServiceLoadBalancerStatus `json:"loadBalancer,omitempty"`
+
+	// Conditions describes the observed conditions of the service.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineServiceLoadBalancerStatus represents the status of a load balancer
+type VirtualMachineServiceLoadBalancerStatus struct {
+	// Ingress is a list containing the ingress points of the load balancer.
+	Ingress []VirtualMachineServiceLoadBalancerIngress `json:"ingress,omitempty"`
+}
+
+// VirtualMachineServiceLoadBalancerIngress represents the ingress point of a load balancer
+type VirtualMachineServiceLoadBalancerIngress struct {
+	IP       string `json:"ip,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+}
+
+const (
+	// Types of VirtualMachineService
+	VirtualMachineServiceTypeLoadBalancer = "LoadBalancer"
+	VirtualMachineServiceTypeClusterIP    = "ClusterIP"
+)
+
+// Constants for VM Operator VirtualMachineService resource types
+const (
+	VirtualMachineServiceKind     = "VirtualMachineService"
+	VirtualMachineServiceResource = "virtualmachineservices"
+)
+
+// Labels for logging and error messages
+const (
+	LabelVirtualMachineService  = "Virtual Machine Service"
+	LabelVirtualMachineServices = "Virtual Machine Services"
+)
+
+// GetVirtualMachineServiceGVR returns the GroupVersionResource for VM Operator VirtualMachineService
+func GetVirtualMachineServiceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    VmOperatorGroup,
+		Version:  VmOperatorVersion,
+		Resource: VirtualMachineServiceResource,
+	}
+}
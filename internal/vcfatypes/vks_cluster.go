@@ -13,6 +13,9 @@ import (
 // This provides full access to all ClusterAPI v1beta2 Cluster CRD fields
 type VksCluster = clusterv1.Cluster
 
+// VksClusterList is an alias for the ClusterAPI v1beta2 ClusterList type
+type VksClusterList = clusterv1.ClusterList
+
 // VksClusterTopology is an alias for the ClusterAPI v1beta2 Topology type
 type VksClusterTopology = clusterv1.Topology
 
@@ -60,7 +63,8 @@ const (
 	AutoscalerMaxSizeAnnotationKey = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
 
 	// V1Beta2 condition types (using metav1.Condition)
-	VksConditionAvailable = "Available"
+	VksConditionAvailable          = "Available"
+	VksConditionTopologyReconciled = "TopologyReconciled"
 )
 
 // Constants for ClusterAPI resource types and versions
@@ -71,8 +75,11 @@ const (
 	VksClusterResource = "clusters"
 )
 
-// Label for logging and error messages
-const LabelVksCluster = "VKS Cluster"
+// Labels for logging and error messages
+const (
+	LabelVksCluster  = "VKS Cluster"
+	LabelVksClusters = "VKS Clusters"
+)
 
 // getVksClusterGVR returns the GroupVersionResource for ClusterAPI v1beta2 Cluster
 func GetVksClusterGVR() schema.GroupVersionResource {
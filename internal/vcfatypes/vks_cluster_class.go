@@ -12,6 +12,9 @@ import (
 // VksClusterClass is an alias for the ClusterAPI v1beta2 ClusterClass type
 type VksClusterClass = clusterv1.ClusterClass
 
+// VksClusterClassList is an alias for the ClusterAPI v1beta2 ClusterClassList type
+type VksClusterClassList = clusterv1.ClusterClassList
+
 // VksClusterClassStatus is an alias for the ClusterAPI v1beta2 ClusterClassStatus type
 type VksClusterClassStatus = clusterv1.ClusterClassStatus
 
@@ -39,8 +42,11 @@ const (
 	VksClusterClassSystemNamespace = "vmware-system-vks-public"
 )
 
-// Label for logging and error messages
-const LabelVksClusterClass = "VKS ClusterClass"
+// Labels for logging and error messages
+const (
+	LabelVksClusterClass   = "VKS ClusterClass"
+	LabelVksClusterClasses = "VKS ClusterClasses"
+)
 
 // GetVksClusterClassGVR returns the GroupVersionResource for ClusterAPI v1beta2 ClusterClass
 func GetVksClusterClassGVR() schema.GroupVersionResource {
@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// VksMachine is an alias for the ClusterAPI v1beta2 Machine type
+type VksMachine = clusterv1.Machine
+
+// VksMachineList is an alias for the ClusterAPI v1beta2 MachineList type
+type VksMachineList = clusterv1.MachineList
+
+// VksMachineSet is an alias for the ClusterAPI v1beta2 MachineSet type
+type VksMachineSet = clusterv1.MachineSet
+
+// VksMachineSetList is an alias for the ClusterAPI v1beta2 MachineSetList type
+type VksMachineSetList = clusterv1.MachineSetList
+
+const (
+	// VksMachineControlPlaneLabel is set on the Machines that are part of the control plane
+	VksMachineControlPlaneLabel = clusterv1.MachineControlPlaneLabel
+
+	// VksMachineSetNameLabel is set on the Machines controlled by a MachineSet
+	VksMachineSetNameLabel = clusterv1.MachineSetNameLabel
+
+	// VksMachineDeploymentNameLabel is set on the Machines and MachineSets controlled by a MachineDeployment
+	VksMachineDeploymentNameLabel = clusterv1.MachineDeploymentNameLabel
+)
+
+// Constants for ClusterAPI Machine and MachineSet resource types
+const (
+	VksMachineKind        = "Machine"
+	VksMachineResource    = "machines"
+	VksMachineSetKind     = "MachineSet"
+	VksMachineSetResource = "machinesets"
+)
+
+// Labels for logging and error messages
+const (
+	LabelVksMachine     = "VKS Machine"
+	LabelVksMachines    = "VKS Machines"
+	LabelVksMachineSet  = "VKS MachineSet"
+	LabelVksMachineSets = "VKS MachineSets"
+)
+
+// GetVksMachineGVR returns the GroupVersionResource for ClusterAPI v1beta2 Machine
+func GetVksMachineGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    VksClusterGroup,
+		Version:  VksClusterVersion,
+		Resource: VksMachineResource,
+	}
+}
+
+// GetVksMachineSetGVR returns the GroupVersionResource for ClusterAPI v1beta2 MachineSet
+func GetVksMachineSetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    VksClusterGroup,
+		Version:  VksClusterVersion,
+		Resource: VksMachineSetResource,
+	}
+}
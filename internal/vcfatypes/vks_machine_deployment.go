@@ -0,0 +1,46 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// VksMachineDeployment is an alias for the ClusterAPI v1beta2 MachineDeployment type
+type VksMachineDeployment = clusterv1.MachineDeployment
+
+// VksMachineDeploymentList is an alias for the ClusterAPI v1beta2 MachineDeploymentList type
+type VksMachineDeploymentList = clusterv1.MachineDeploymentList
+
+const (
+	// VksClusterNameLabel is set by ClusterAPI on every object owned by a Cluster
+	VksClusterNameLabel = clusterv1.ClusterNameLabel
+
+	// VksTopologyMachineDeploymentNameLabel is set on the MachineDeployments generated from a
+	// Cluster topology and holds the name of the machine_deployments entry
+	VksTopologyMachineDeploymentNameLabel = clusterv1.ClusterTopologyMachineDeploymentNameLabel
+)
+
+// Constants for ClusterAPI MachineDeployment resource types
+const (
+	VksMachineDeploymentKind     = "MachineDeployment"
+	VksMachineDeploymentResource = "machinedeployments"
+)
+
+// Labels for logging and error messages
+const (
+	LabelVksMachineDeployment  = "VKS MachineDeployment"
+	LabelVksMachineDeployments = "VKS MachineDeployments"
+)
+
+// GetVksMachineDeploymentGVR returns the GroupVersionResource for ClusterAPI v1beta2 MachineDeployment
+func GetVksMachineDeploymentGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    VksClusterGroup,
+		Version:  VksClusterVersion,
+		Resource: VksMachineDeploymentResource,
+	}
+}
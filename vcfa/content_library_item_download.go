@@ -0,0 +1,284 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// contentLibraryItemDownloadFilesEndpoint is used to retrieve the files of a Content Library Item with their download URLs
+const contentLibraryItemDownloadFilesEndpoint = "contentLibraryItems/%s/downloadFiles"
+
+// contentLibraryItemDownloadManifestName is the name of the file, inside the download directory, that records the
+// downloaded files, so the files that did not change are not hashed again on every read
+const contentLibraryItemDownloadManifestName = ".vcfa-download-manifest.json"
+
+// contentLibraryItemDownloadedFile is the record of a downloaded file in the download manifest
+type contentLibraryItemDownloadedFile struct {
+	// Size and modification time of the file when its checksum was calculated, see fileFingerprint
+	Fingerprint string `json:"fingerprint"`
+	// SHA-256 checksum of the file
+	Checksum string `json:"checksum"`
+}
+
+// tmContentLibraryItemDownloadFile represents a file of a Content Library Item that can be downloaded
+type tmContentLibraryItemDownloadFile struct {
+	// Name of the file, like 'descriptor.ovf' or 'disk1.vmdk'
+	Name string `json:"name"`
+	// Size of the file in bytes
+	SizeBytes int64 `json:"sizeBytes"`
+	// Checksum of the file contents, which can be empty if VCFA did not calculate it
+	Checksum string `json:"checksum,omitempty"`
+	// Algorithm used to calculate the checksum, like 'SHA256'
+	ChecksumAlgorithm string `json:"checksumAlgorithm,omitempty"`
+	// URL to download the file from
+	DownloadUrl string `json:"downloadUrl"`
+}
+
+// contentLibraryItemDownloadResult contains the local files of a downloaded Content Library Item
+type contentLibraryItemDownloadResult struct {
+	// Local paths of the files, sorted so they can be used in 'file_paths' of a Content Library Item
+	filePaths []string
+	// SHA-256 checksums of the files, keyed by file name
+	checksums map[string]string
+	// Names of the files that were downloaded
+	downloaded []string
+	// Names of the files that were skipped, as they were already present with the same contents
+	skipped []string
+}
+
+// getContentLibraryItemDownloadFiles retrieves the files of the given Content Library Item with their download URLs
+func getContentLibraryItemDownloadFiles(tmClient *VCDClient, cli *govcd.ContentLibraryItem) ([]*tmContentLibraryItemDownloadFile, error) {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + fmt.Sprintf(contentLibraryItemDownloadFilesEndpoint, cli.ContentLibraryItem.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*tmContentLibraryItemDownloadFile
+	err = client.OpenApiGetAllItems(client.APIVersion, urlRef, nil, &files, getContentLibraryTenantHeaders(cli.ContentLibraryItem.Org))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving files of %s '%s': %s", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s '%s' does not have any file to download", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name)
+	}
+	return files, nil
+}
+
+// downloadContentLibraryItem downloads all the files of the given Content Library Item to the given directory. Files that
+// are already present in the directory with the same contents are not downloaded again. The checksum of every file is
+// recorded in the download manifest of the directory, see localFileMatches
+func downloadContentLibraryItem(tmClient *VCDClient, cli *govcd.ContentLibraryItem, directory string, progressInterval time.Duration) (*contentLibraryItemDownloadResult, error) {
+	files, err := getContentLibraryItemDownloadFiles(tmClient, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(directory, 0750)
+	if err != nil {
+		return nil, fmt.Errorf("error creating download directory '%s': %s", directory, err)
+	}
+
+	result := &contentLibraryItemDownloadResult{
+		checksums:  make(map[string]string, len(files)),
+		downloaded: make([]string, 0),
+		skipped:    make([]string, 0),
+	}
+	manifest := loadContentLibraryItemDownloadManifest(directory)
+	for _, file := range files {
+		// Names come from the API, they must not escape the download directory
+		name := filepath.Base(filepath.Clean(file.Name))
+		localPath := filepath.Join(directory, name)
+
+		var recorded *contentLibraryItemDownloadedFile
+		if r, ok := manifest[name]; ok {
+			recorded = &r
+		}
+		checksum, matches, err := localFileMatches(localPath, file, recorded)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			log.Printf("[DEBUG] file '%s' of %s '%s' is already downloaded, skipping it", name, labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name)
+			result.skipped = append(result.skipped, name)
+		} else {
+			checksum, err = downloadContentLibraryItemFile(&tmClient.VCDClient.Client, file, localPath, progressInterval)
+			if err != nil {
+				return nil, fmt.Errorf("error downloading file '%s' of %s '%s': %s", name, labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+			}
+			result.downloaded = append(result.downloaded, name)
+		}
+		// The manifest is saved after every file, so the files downloaded before an interruption are not hashed again
+		fileInfo, err := os.Stat(localPath)
+		if err != nil {
+			return nil, err
+		}
+		manifest[name] = contentLibraryItemDownloadedFile{Fingerprint: fileFingerprint(fileInfo), Checksum: checksum}
+		saveContentLibraryItemDownloadManifest(directory, manifest)
+
+		result.checksums[name] = checksum
+		result.filePaths = append(result.filePaths, localPath)
+	}
+
+	// The OVF descriptor must be the first file of a Content Library Item made of several files
+	sort.SliceStable(result.filePaths, func(i, j int) bool {
+		return strings.EqualFold(filepath.Ext(result.filePaths[i]), ".ovf") && !strings.EqualFold(filepath.Ext(result.filePaths[j]), ".ovf")
+	})
+	return result, nil
+}
+
+// localFileMatches checks whether the given local file has the same contents as the remote file, returning its
+// SHA-256 checksum in that case. If the size and modification time of the file are the ones recorded when it was
+// downloaded, the recorded checksum is used. Otherwise, the file is hashed again, which is only possible when VCFA
+// provides a SHA-256 checksum to compare with: if it does not, the file does not match, so it is downloaded again
+func localFileMatches(localPath string, file *tmContentLibraryItemDownloadFile, recorded *contentLibraryItemDownloadedFile) (string, bool, error) {
+	info, err := os.Stat(localPath)
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	if info.IsDir() || info.Size() != file.SizeBytes {
+		return "", false, nil
+	}
+
+	expected := getExpectedSha256(file)
+	var checksum string
+	switch {
+	case recorded != nil && recorded.Fingerprint == fileFingerprint(info):
+		checksum = recorded.Checksum
+	case expected != "":
+		checksum, err = fileSha256(localPath)
+		if err != nil {
+			return "", false, err
+		}
+	default:
+		log.Printf("[DEBUG] file '%s' cannot be verified, as it changed since it was downloaded and VCFA does not provide its SHA-256 checksum", localPath)
+		return "", false, nil
+	}
+	if expected != "" && !strings.EqualFold(expected, checksum) {
+		return "", false, nil
+	}
+	return checksum, true, nil
+}
+
+// loadContentLibraryItemDownloadManifest reads the download manifest of the given directory. It returns an empty
+// manifest if there is none, or if it cannot be read
+func loadContentLibraryItemDownloadManifest(directory string) map[string]contentLibraryItemDownloadedFile {
+	manifest := make(map[string]contentLibraryItemDownloadedFile)
+	manifestPath := filepath.Join(directory, contentLibraryItemDownloadManifestName)
+	content, err := os.ReadFile(filepath.Clean(manifestPath))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] could not read the download manifest '%s': %s", manifestPath, err)
+		}
+		return manifest
+	}
+	if err = json.Unmarshal(content, &manifest); err != nil {
+		log.Printf("[DEBUG] ignoring invalid download manifest '%s': %s", manifestPath, err)
+		return make(map[string]contentLibraryItemDownloadedFile)
+	}
+	return manifest
+}
+
+// saveContentLibraryItemDownloadManifest writes the download manifest of the given directory. Failing to save it is
+// not an error, as it only makes the next read hash the files again, or download them if they cannot be verified
+func saveContentLibraryItemDownloadManifest(directory string, manifest map[string]contentLibraryItemDownloadedFile) {
+	manifestPath := filepath.Join(directory, contentLibraryItemDownloadManifestName)
+	content, err := json.Marshal(manifest)
+	if err == nil {
+		// The manifest is replaced atomically, so an interruption never leaves it half written
+		tmpPath := manifestPath + ".tmp"
+		err = os.WriteFile(tmpPath, content, 0600)
+		if err == nil {
+			err = os.Rename(tmpPath, manifestPath)
+		}
+	}
+	if err != nil {
+		log.Printf("[DEBUG] could not save the download manifest '%s': %s", manifestPath, err)
+	}
+}
+
+// getExpectedSha256 returns the SHA-256 checksum provided by VCFA for the given file, or an empty string if there is none
+func getExpectedSha256(file *tmContentLibraryItemDownloadFile) string {
+	algorithm := strings.ReplaceAll(strings.ToUpper(file.ChecksumAlgorithm), "-", "")
+	if file.Checksum == "" || (algorithm != "" && algorithm != "SHA256") {
+		return ""
+	}
+	return strings.TrimPrefix(file.Checksum, "sha256:")
+}
+
+// downloadContentLibraryItemFile downloads the given file to the local path, verifying its size and checksum. The file
+// is written to a temporary file first, so the local path never contains a partial or corrupted file
+func downloadContentLibraryItemFile(client *govcd.Client, file *tmContentLibraryItemDownloadFile, localPath string, progressInterval time.Duration) (string, error) {
+	parsedUrl, err := url.ParseRequestURI(file.DownloadUrl)
+	if err != nil {
+		return "", fmt.Errorf("error parsing download URL '%s': %s", file.DownloadUrl, err)
+	}
+
+	req := client.NewRequestWitNotEncodedParams(nil, nil, http.MethodGet, *parsedUrl, nil)
+	resp, err := client.Http.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer closeResponseBody(resp)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("got HTTP status %s", resp.Status)
+	}
+
+	tmpPath := localPath + ".part"
+	out, err := os.Create(filepath.Clean(tmpPath))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		// Nothing to remove if the file was renamed already
+		if err := os.Remove(tmpPath); err != nil && !os.IsNotExist(err) {
+			log.Printf("[DEBUG] could not remove temporary file '%s': %s", tmpPath, err)
+		}
+	}()
+
+	h := sha256.New()
+	progress := newTransferProgress("Downloaded", filepath.Base(localPath), 0, file.SizeBytes, progressInterval)
+	written, err := io.Copy(io.MultiWriter(out, h, progress), resp.Body)
+	progress.stop()
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if written != file.SizeBytes {
+		return "", fmt.Errorf("expected %d bytes but got %d", file.SizeBytes, written)
+	}
+	checksum := hex.EncodeToString(h.Sum(nil))
+	if expected := getExpectedSha256(file); expected != "" && !strings.EqualFold(expected, checksum) {
+		return "", fmt.Errorf("the SHA-256 checksum of '%s' is '%s', but expected '%s'", file.Name, checksum, expected)
+	}
+
+	err = os.Rename(tmpPath, localPath)
+	if err != nil {
+		return "", err
+	}
+	return checksum, nil
+}
@@ -0,0 +1,106 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLocalFileMatches(t *testing.T) {
+	content := []byte("content")
+	hash := sha256.Sum256(content)
+	checksum := hex.EncodeToString(hash[:])
+
+	type testCase struct {
+		name             string
+		remoteSize       int64
+		remoteChecksum   string
+		recordedChecksum string // If set, the file is recorded with its current fingerprint
+		touched          bool   // Whether the modification time of the file changes after it is recorded
+		expectedMatch    bool
+		expectedChecksum string
+	}
+
+	testCases := []testCase{
+		// The recorded checksum is used as is, so a fake one proves that the file is not hashed again
+		{name: "RecordedWithoutRemoteChecksum", remoteSize: 7, recordedChecksum: "recorded", expectedMatch: true, expectedChecksum: "recorded"},
+		{name: "RecordedWithRemoteChecksum", remoteSize: 7, remoteChecksum: checksum, recordedChecksum: checksum, expectedMatch: true, expectedChecksum: checksum},
+		{name: "RecordedWithDifferentRemoteChecksum", remoteSize: 7, remoteChecksum: "other", recordedChecksum: checksum},
+		{name: "ModifiedWithRemoteChecksum", remoteSize: 7, remoteChecksum: checksum, recordedChecksum: "recorded", touched: true, expectedMatch: true, expectedChecksum: checksum},
+		{name: "ModifiedWithoutRemoteChecksum", remoteSize: 7, recordedChecksum: checksum, touched: true},
+		{name: "NotRecordedWithRemoteChecksum", remoteSize: 7, remoteChecksum: "sha256:" + checksum, expectedMatch: true, expectedChecksum: checksum},
+		{name: "NotRecordedWithWrongRemoteChecksum", remoteSize: 7, remoteChecksum: "other"},
+		{name: "NotRecordedWithoutRemoteChecksum", remoteSize: 7},
+		{name: "DifferentSize", remoteSize: 8, remoteChecksum: checksum, recordedChecksum: checksum},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			localPath := filepath.Join(t.TempDir(), "test.iso")
+			if err := os.WriteFile(localPath, content, 0600); err != nil {
+				t.Fatal(err)
+			}
+			var recorded *contentLibraryItemDownloadedFile
+			if tc.recordedChecksum != "" {
+				info, err := os.Stat(localPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				recorded = &contentLibraryItemDownloadedFile{Fingerprint: fileFingerprint(info), Checksum: tc.recordedChecksum}
+			}
+			if tc.touched {
+				if err := os.Chtimes(localPath, time.Now(), time.Now().Add(-time.Hour)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			file := &tmContentLibraryItemDownloadFile{Name: "test.iso", SizeBytes: tc.remoteSize, Checksum: tc.remoteChecksum}
+			got, matches, err := localFileMatches(localPath, file, recorded)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if matches != tc.expectedMatch || got != tc.expectedChecksum {
+				t.Errorf("expected match %t with checksum %q, got %t with %q", tc.expectedMatch, tc.expectedChecksum, matches, got)
+			}
+		})
+	}
+
+	_, matches, err := localFileMatches(filepath.Join(t.TempDir(), "missing.iso"), &tmContentLibraryItemDownloadFile{SizeBytes: 7}, nil)
+	if err != nil || matches {
+		t.Errorf("expected a missing file to not match, got %t and error %v", matches, err)
+	}
+}
+
+func TestContentLibraryItemDownloadManifest(t *testing.T) {
+	dir := t.TempDir()
+	if manifest := loadContentLibraryItemDownloadManifest(dir); len(manifest) != 0 {
+		t.Fatalf("expected an empty manifest when there is none, got %v", manifest)
+	}
+
+	manifest := map[string]contentLibraryItemDownloadedFile{
+		"descriptor.ovf": {Fingerprint: "10-1", Checksum: "aaaa"},
+		"disk1.vmdk":     {Fingerprint: "20-2", Checksum: "bbbb"},
+	}
+	saveContentLibraryItemDownloadManifest(dir, manifest)
+	if got := loadContentLibraryItemDownloadManifest(dir); !reflect.DeepEqual(got, manifest) {
+		t.Errorf("expected manifest %v, got %v", manifest, got)
+	}
+
+	// An invalid manifest is ignored, so the files are verified again
+	if err := os.WriteFile(filepath.Join(dir, contentLibraryItemDownloadManifestName), []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got := loadContentLibraryItemDownloadManifest(dir); len(got) != 0 {
+		t.Errorf("expected an empty manifest after reading an invalid one, got %v", got)
+	}
+}
@@ -0,0 +1,371 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" // #nosec G505 -- SHA-1 is only used to verify OVF manifests that were generated with it
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ovfManifestLineRegex matches the lines of an OVF manifest, like 'SHA256(disk1.vmdk)= 3a5b...'
+var ovfManifestLineRegex = regexp.MustCompile(`^(SHA1|SHA256|SHA512)\s*\((.+)\)\s*=\s*([0-9a-fA-F]+)$`)
+
+// ovfChunkSuffixRegex matches the suffix of the files of a chunked OVF reference, like 'disk1.vmdk.000000001'
+var ovfChunkSuffixRegex = regexp.MustCompile(`^\.\d{9}$`)
+
+// ovfDescriptor contains the parts of an OVF descriptor that are needed to validate an OVF package
+type ovfDescriptor struct {
+	XMLName xml.Name            `xml:"Envelope"`
+	Files   []ovfReferencedFile `xml:"References>File"`
+}
+
+// ovfReferencedFile is a 'References/File' entry of an OVF descriptor
+type ovfReferencedFile struct {
+	Href      string `xml:"href,attr"`
+	Id        string `xml:"id,attr"`
+	Size      string `xml:"size,attr"`
+	ChunkSize string `xml:"chunkSize,attr"`
+}
+
+// ovfManifestEntry is a line of an OVF manifest
+type ovfManifestEntry struct {
+	algorithm string
+	fileName  string
+	checksum  string
+}
+
+// ovfPackage is an OVF descriptor with the local files of its package
+type ovfPackage struct {
+	descriptorPath string
+	descriptor     *ovfDescriptor
+	manifestPath   string             // Empty if the package does not have a manifest
+	manifest       []ovfManifestEntry // Entries of the manifest, if any
+	filePaths      map[string]string  // Local paths of the files of the package, indexed by file name
+}
+
+// getOvfPackage parses the OVF descriptor and manifest among the given file paths. It returns nil if there is no
+// OVF descriptor, as the files are then an ISO or an OVA
+func getOvfPackage(filePaths []string) (*ovfPackage, error) {
+	pkg := &ovfPackage{filePaths: make(map[string]string, len(filePaths))}
+	for _, p := range filePaths {
+		name := filepath.Base(p)
+		if other, ok := pkg.filePaths[name]; ok {
+			return nil, fmt.Errorf("the files '%s' and '%s' have the same name", other, p)
+		}
+		pkg.filePaths[name] = p
+
+		switch strings.ToLower(filepath.Ext(p)) {
+		case ".ovf":
+			if pkg.descriptorPath != "" {
+				return nil, fmt.Errorf("only one OVF descriptor is allowed, but got '%s' and '%s'", pkg.descriptorPath, p)
+			}
+			pkg.descriptorPath = p
+		case ".mf":
+			if pkg.manifestPath != "" {
+				return nil, fmt.Errorf("only one OVF manifest is allowed, but got '%s' and '%s'", pkg.manifestPath, p)
+			}
+			pkg.manifestPath = p
+		}
+	}
+	if pkg.descriptorPath == "" {
+		return nil, nil
+	}
+
+	descriptor, err := parseOvfDescriptor(pkg.descriptorPath)
+	if err != nil {
+		return nil, err
+	}
+	pkg.descriptor = descriptor
+
+	if pkg.manifestPath != "" {
+		pkg.manifest, err = parseOvfManifest(pkg.manifestPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pkg, nil
+}
+
+// parseOvfDescriptor reads the OVF descriptor located in the given path
+func parseOvfDescriptor(descriptorPath string) (*ovfDescriptor, error) {
+	f, err := os.Open(filepath.Clean(descriptorPath))
+	if err != nil {
+		return nil, err
+	}
+	defer closeOvfFile(f)
+
+	descriptor := &ovfDescriptor{}
+	err = xml.NewDecoder(f).Decode(descriptor)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse OVF descriptor '%s': %s", descriptorPath, err)
+	}
+	return descriptor, nil
+}
+
+// parseOvfManifest reads the OVF manifest located in the given path
+func parseOvfManifest(manifestPath string) ([]ovfManifestEntry, error) {
+	f, err := os.Open(filepath.Clean(manifestPath))
+	if err != nil {
+		return nil, err
+	}
+	defer closeOvfFile(f)
+
+	var entries []ovfManifestEntry
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		matches := ovfManifestLineRegex.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, fmt.Errorf("could not parse line %d of OVF manifest '%s': '%s'", lineNumber, manifestPath, line)
+		}
+		entries = append(entries, ovfManifestEntry{
+			algorithm: matches[1],
+			fileName:  matches[2],
+			checksum:  strings.ToLower(matches[3]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read OVF manifest '%s': %s", manifestPath, err)
+	}
+	return entries, nil
+}
+
+// validate checks that every file referenced by the OVF descriptor is present with the expected size, and that the
+// checksums of the OVF manifest match. The given SHA-256 checksums, indexed by file name, are used when available
+// so files are not read again
+func (pkg *ovfPackage) validate(sha256Checksums map[string]string) error {
+	var problems []string
+	for _, file := range pkg.descriptor.Files {
+		if isRemoteOvfReference(file.Href) {
+			continue
+		}
+		if err := pkg.validateReferencedFile(file); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	for _, entry := range pkg.manifest {
+		localPath, ok := pkg.filePaths[entry.fileName]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("file '%s' is listed in the OVF manifest but it is not in 'file_paths'", entry.fileName))
+			continue
+		}
+		checksum := sha256Checksums[entry.fileName]
+		if entry.algorithm != "SHA256" || checksum == "" {
+			var err error
+			checksum, err = fileChecksum(localPath, entry.algorithm)
+			if err != nil {
+				return fmt.Errorf("could not calculate the %s checksum of '%s': %s", entry.algorithm, localPath, err)
+			}
+		}
+		if checksum != entry.checksum {
+			problems = append(problems, fmt.Sprintf("the %s checksum of '%s' is '%s', but the OVF manifest expects '%s'",
+				entry.algorithm, localPath, checksum, entry.checksum))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid OVF package '%s':\n- %s", pkg.descriptorPath, strings.Join(problems, "\n- "))
+	}
+	return nil
+}
+
+// validateReferencedFile checks that the given file referenced by the OVF descriptor is present with the expected size.
+// Chunked files must have all their chunks
+func (pkg *ovfPackage) validateReferencedFile(file ovfReferencedFile) error {
+	name := path.Base(file.Href)
+
+	var size int64
+	if file.ChunkSize == "" {
+		localPath, ok := pkg.filePaths[name]
+		if !ok {
+			return fmt.Errorf("file '%s' is referenced by the OVF descriptor but it is not in 'file_paths'", name)
+		}
+		info, err := os.Stat(localPath)
+		if err != nil {
+			return err
+		}
+		size = info.Size()
+	} else {
+		chunks := pkg.getChunks(name)
+		if len(chunks) == 0 {
+			return fmt.Errorf("file '%s' is referenced by the OVF descriptor in chunks, but none of them is in 'file_paths'", name)
+		}
+		for i, chunk := range chunks {
+			if expected := fmt.Sprintf("%s.%09d", name, i); filepath.Base(chunk) != expected {
+				return fmt.Errorf("chunk '%s' of file '%s' is not in 'file_paths'", expected, name)
+			}
+			info, err := os.Stat(chunk)
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+	}
+
+	if file.Size == "" {
+		return nil
+	}
+	expectedSize, err := strconv.ParseInt(file.Size, 10, 64)
+	if err != nil {
+		return fmt.Errorf("file '%s' has an invalid size in the OVF descriptor: '%s'", name, file.Size)
+	}
+	if size != expectedSize {
+		return fmt.Errorf("file '%s' has %d bytes, but the OVF descriptor expects %d", name, size, expectedSize)
+	}
+	return nil
+}
+
+// getChunks returns the local paths of the chunks of the given file, sorted by their position
+func (pkg *ovfPackage) getChunks(name string) []string {
+	var chunks []string
+	for fileName, localPath := range pkg.filePaths {
+		if strings.HasPrefix(fileName, name) && ovfChunkSuffixRegex.MatchString(strings.TrimPrefix(fileName, name)) {
+			chunks = append(chunks, localPath)
+		}
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		return filepath.Base(chunks[i]) < filepath.Base(chunks[j])
+	})
+	return chunks
+}
+
+// unreferencedFiles returns the local paths of the files that are not the descriptor, the manifest, a certificate or any
+// file referenced by the OVF descriptor. These files are uploaded, but VCFA does not use them
+func (pkg *ovfPackage) unreferencedFiles() []string {
+	referenced := make(map[string]bool, len(pkg.descriptor.Files))
+	for _, file := range pkg.descriptor.Files {
+		referenced[path.Base(file.Href)] = true
+	}
+
+	var result []string
+	for fileName, localPath := range pkg.filePaths {
+		if localPath == pkg.descriptorPath || localPath == pkg.manifestPath || strings.EqualFold(filepath.Ext(fileName), ".cert") {
+			continue
+		}
+		if referenced[fileName] {
+			continue
+		}
+		if ext := filepath.Ext(fileName); ovfChunkSuffixRegex.MatchString(ext) && referenced[strings.TrimSuffix(fileName, ext)] {
+			continue
+		}
+		result = append(result, localPath)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// isRemoteOvfReference returns true if the given OVF reference points to a URL instead of a file of the package
+func isRemoteOvfReference(href string) bool {
+	return strings.Contains(href, "://")
+}
+
+// fileChecksum returns the hexadecimal checksum of the file located in the given path, with the given OVF manifest
+// algorithm
+func fileChecksum(filePath, algorithm string) (string, error) {
+	var h hash.Hash
+	switch algorithm {
+	case "SHA1":
+		h = sha1.New() // #nosec G401 -- Required to verify OVF manifests that were generated with SHA-1
+	case "SHA256":
+		h = sha256.New()
+	case "SHA512":
+		h = sha512.New()
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm '%s'", algorithm)
+	}
+
+	f, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return "", err
+	}
+	defer closeOvfFile(f)
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func closeOvfFile(f *os.File) {
+	if err := f.Close(); err != nil {
+		log.Printf("[DEBUG] could not close file '%s': %s", f.Name(), err)
+	}
+}
+
+// validateContentLibraryItemFiles validates the OVF package in the given file paths, if there is any. The SHA-256
+// checksums of the files, indexed by file name, are used to verify the OVF manifest
+func validateContentLibraryItemFiles(filePaths []string, sha256Checksums map[string]string) error {
+	pkg, err := getOvfPackage(filePaths)
+	if err != nil || pkg == nil {
+		return err
+	}
+	return pkg.validate(sha256Checksums)
+}
+
+// validateContentLibraryItemUnreferencedFiles warns about the files in 'file_paths' that are not referenced by the OVF
+// descriptor. Problems are not reported here, as they are reported when planning
+func validateContentLibraryItemUnreferencedFiles(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	filePaths, ok := getRawConfigFilePaths(req.RawConfig)
+	if !ok {
+		return
+	}
+
+	pkg, err := getOvfPackage(filePaths)
+	if err != nil || pkg == nil {
+		return
+	}
+	for _, p := range pkg.unreferencedFiles() {
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "File not referenced by the OVF descriptor",
+			Detail:        fmt.Sprintf("'%s' is not referenced by the OVF descriptor '%s', it will be uploaded but not used", p, pkg.descriptorPath),
+			AttributePath: cty.GetAttrPath("file_paths"),
+		})
+	}
+}
+
+// getRawConfigFilePaths returns the 'file_paths' of the given raw configuration, and whether they are known
+func getRawConfigFilePaths(rawConfig cty.Value) ([]string, bool) {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil, false
+	}
+	rawFilePaths := rawConfig.GetAttr("file_paths")
+	if rawFilePaths.IsNull() || !rawFilePaths.IsWhollyKnown() {
+		return nil, false
+	}
+
+	var filePaths []string
+	for it := rawFilePaths.ElementIterator(); it.Next(); {
+		_, v := it.Element()
+		if v.IsNull() || v.Type() != cty.String {
+			return nil, false
+		}
+		filePaths = append(filePaths, v.AsString())
+	}
+	return filePaths, true
+}
@@ -0,0 +1,326 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const ovfValidationFixtures = "../test-resources/ovf_validation"
+
+// getOvfFixturePaths returns the paths of the given files of an OVF fixture
+func getOvfFixturePaths(fixture string, names ...string) []string {
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = filepath.Join(ovfValidationFixtures, fixture, name)
+	}
+	return paths
+}
+
+// copyOvfFixture copies all the files of an OVF fixture to a temporary directory, so they can be modified
+func copyOvfFixture(t *testing.T, fixture string) string {
+	entries, err := os.ReadDir(filepath.Join(ovfValidationFixtures, fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(ovfValidationFixtures, fixture, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(dir, entry.Name()), content, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestValidateContentLibraryItemFiles(t *testing.T) {
+	// The first file of every case is modified with the given function, if any
+	type testCase struct {
+		name          string
+		fixture       string
+		files         []string
+		modify        func(t *testing.T, path string)
+		expectedError []string
+	}
+
+	appendBytes := func(t *testing.T, path string) {
+		f, err := os.OpenFile(filepath.Clean(path), os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		if _, err = f.WriteString("extra"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	flipFirstByte := func(t *testing.T, path string) {
+		content, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		content[0] ^= 0xff
+		if err = os.WriteFile(path, content, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []testCase{
+		{
+			name:    "ValidWithSha256Manifest",
+			fixture: "valid",
+			files:   []string{"descriptor.ovf", "disk1.vmdk", "disk2.vmdk", "descriptor.mf"},
+		},
+		{
+			name:    "ValidWithoutManifest",
+			fixture: "valid",
+			files:   []string{"descriptor.ovf", "disk1.vmdk", "disk2.vmdk"},
+		},
+		{
+			name:    "ValidWithSha1Manifest",
+			fixture: "sha1",
+			files:   []string{"descriptor.ovf", "disk1.vmdk", "descriptor.mf"},
+		},
+		{
+			name:    "ValidChunked",
+			fixture: "chunked",
+			files:   []string{"descriptor.ovf", "disk1.vmdk.000000000", "disk1.vmdk.000000001"},
+		},
+		{
+			name:    "NotAnOvf",
+			fixture: "valid",
+			files:   []string{"disk1.vmdk"},
+		},
+		{
+			name:          "MissingReferencedFile",
+			fixture:       "valid",
+			files:         []string{"descriptor.ovf", "disk1.vmdk"},
+			expectedError: []string{"file 'disk2.vmdk' is referenced by the OVF descriptor but it is not in 'file_paths'"},
+		},
+		{
+			name:          "MissingChunk",
+			fixture:       "chunked",
+			files:         []string{"descriptor.ovf", "disk1.vmdk.000000001"},
+			expectedError: []string{"chunk 'disk1.vmdk.000000000' of file 'disk1.vmdk' is not in 'file_paths'"},
+		},
+		{
+			name:          "MissingFileListedInManifest",
+			fixture:       "sha1",
+			files:         []string{"descriptor.ovf", "descriptor.mf"},
+			expectedError: []string{"file 'disk1.vmdk' is referenced by the OVF descriptor", "file 'disk1.vmdk' is listed in the OVF manifest"},
+		},
+		{
+			name:          "SizeMismatch",
+			fixture:       "valid",
+			files:         []string{"disk1.vmdk", "descriptor.ovf", "disk2.vmdk"},
+			modify:        appendBytes,
+			expectedError: []string{"file 'disk1.vmdk' has 59 bytes, but the OVF descriptor expects 54"},
+		},
+		{
+			name:          "ChunkedSizeMismatch",
+			fixture:       "chunked",
+			files:         []string{"disk1.vmdk.000000001", "descriptor.ovf", "disk1.vmdk.000000000"},
+			modify:        appendBytes,
+			expectedError: []string{"file 'disk1.vmdk' has 59 bytes, but the OVF descriptor expects 54"},
+		},
+		{
+			name:          "Sha256ChecksumMismatch",
+			fixture:       "valid",
+			files:         []string{"disk2.vmdk", "descriptor.ovf", "disk1.vmdk", "descriptor.mf"},
+			modify:        flipFirstByte,
+			expectedError: []string{"the SHA256 checksum of", "disk2.vmdk", "but the OVF manifest expects '60348bcdc7ebd96523dba06e58850cbdda750eaa8313e45241d2fd7a5f45a119'"},
+		},
+		{
+			name:          "Sha1ChecksumMismatch",
+			fixture:       "sha1",
+			files:         []string{"disk1.vmdk", "descriptor.ovf", "descriptor.mf"},
+			modify:        flipFirstByte,
+			expectedError: []string{"the SHA1 checksum of", "disk1.vmdk"},
+		},
+		{
+			name:    "InvalidManifest",
+			fixture: "valid",
+			files:   []string{"descriptor.mf", "descriptor.ovf", "disk1.vmdk", "disk2.vmdk"},
+			modify: func(t *testing.T, path string) {
+				if err := os.WriteFile(path, []byte("MD5(disk1.vmdk)= 0123\n"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectedError: []string{"could not parse line 1 of OVF manifest"},
+		},
+		{
+			name:    "InvalidDescriptor",
+			fixture: "valid",
+			files:   []string{"descriptor.ovf", "disk1.vmdk", "disk2.vmdk"},
+			modify: func(t *testing.T, path string) {
+				if err := os.WriteFile(path, []byte("not an OVF"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expectedError: []string{"could not parse OVF descriptor"},
+		},
+		{
+			name:          "TwoDescriptors",
+			fixture:       "valid",
+			files:         []string{"descriptor.ovf", "../sha1/descriptor.ovf", "disk1.vmdk"},
+			expectedError: []string{"have the same name"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := filepath.Join(ovfValidationFixtures, tc.fixture)
+			if tc.modify != nil {
+				dir = copyOvfFixture(t, tc.fixture)
+			}
+			filePaths := make([]string, len(tc.files))
+			for i, name := range tc.files {
+				filePaths[i] = filepath.Join(dir, name)
+			}
+			if tc.modify != nil {
+				tc.modify(t, filePaths[0])
+			}
+
+			checksums, err := contentLibraryItemChecksums(filePaths)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = validateContentLibraryItemFiles(filePaths, checksums)
+			if len(tc.expectedError) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %v, but got none", tc.expectedError)
+			}
+			for _, expected := range tc.expectedError {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected error to contain '%s', but got: %s", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateContentLibraryItemFilesWithoutChecksums(t *testing.T) {
+	// SHA-256 manifests are also verified when the checksums are not calculated beforehand
+	dir := copyOvfFixture(t, "valid")
+	err := os.WriteFile(filepath.Join(dir, "disk1.vmdk"), []byte("Fake disk used to test the validation of OVF packageS\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filePaths := []string{filepath.Join(dir, "descriptor.ovf"), filepath.Join(dir, "disk1.vmdk"), filepath.Join(dir, "disk2.vmdk"), filepath.Join(dir, "descriptor.mf")}
+	err = validateContentLibraryItemFiles(filePaths, nil)
+	if err == nil || !strings.Contains(err.Error(), "the SHA256 checksum of") {
+		t.Fatalf("expected a checksum error, but got: %v", err)
+	}
+}
+
+func TestOvfPackageUnreferencedFiles(t *testing.T) {
+	certPath := filepath.Join(t.TempDir(), "descriptor.cert")
+	err := os.WriteFile(certPath, []byte("certificate"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		name      string
+		filePaths []string
+		expected  []string
+	}
+	testCases := []testCase{
+		{
+			name:      "NoExtraFiles",
+			filePaths: append(getOvfFixturePaths("valid", "descriptor.ovf", "disk1.vmdk", "disk2.vmdk", "descriptor.mf"), certPath),
+		},
+		{
+			name:      "NoExtraChunks",
+			filePaths: getOvfFixturePaths("chunked", "descriptor.ovf", "disk1.vmdk.000000000", "disk1.vmdk.000000001"),
+		},
+		{
+			name:      "ExtraFiles",
+			filePaths: append(getOvfFixturePaths("sha1", "descriptor.ovf", "disk1.vmdk"), getOvfFixturePaths("valid", "disk2.vmdk")...),
+			expected:  getOvfFixturePaths("valid", "disk2.vmdk"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pkg, err := getOvfPackage(tc.filePaths)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := pkg.unreferencedFiles()
+			if len(got) != 0 || len(tc.expected) != 0 {
+				if !reflect.DeepEqual(got, tc.expected) {
+					t.Errorf("expected unreferenced files %v, but got %v", tc.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateContentLibraryItemUnreferencedFiles(t *testing.T) {
+	toRawConfig := func(filePaths []string) cty.Value {
+		values := make([]cty.Value, len(filePaths))
+		for i, p := range filePaths {
+			values[i] = cty.StringVal(p)
+		}
+		return cty.ObjectVal(map[string]cty.Value{"file_paths": cty.SetVal(values)})
+	}
+
+	type testCase struct {
+		name             string
+		rawConfig        cty.Value
+		expectedWarnings int
+	}
+	testCases := []testCase{
+		{
+			name:             "ExtraFile",
+			rawConfig:        toRawConfig(append(getOvfFixturePaths("sha1", "descriptor.ovf", "disk1.vmdk"), getOvfFixturePaths("valid", "disk2.vmdk")...)),
+			expectedWarnings: 1,
+		},
+		{
+			name:      "NoExtraFiles",
+			rawConfig: toRawConfig(getOvfFixturePaths("valid", "descriptor.ovf", "disk1.vmdk", "disk2.vmdk")),
+		},
+		{
+			name:      "UnknownFilePaths",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{"file_paths": cty.UnknownVal(cty.Set(cty.String))}),
+		},
+		{
+			name:      "NullFilePaths",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{"file_paths": cty.NullVal(cty.Set(cty.String))}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &schema.ValidateResourceConfigFuncResponse{}
+			validateContentLibraryItemUnreferencedFiles(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: tc.rawConfig}, resp)
+			if len(resp.Diagnostics) != tc.expectedWarnings {
+				t.Fatalf("expected %d warnings, but got %d: %v", tc.expectedWarnings, len(resp.Diagnostics), resp.Diagnostics)
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected only warnings, but got errors: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const (
+	// contentLibraryItemApproveEndpoint is used to approve a quarantined Content Library Item
+	contentLibraryItemApproveEndpoint = "contentLibraryItems/%s/approve"
+	// contentLibraryItemRejectEndpoint is used to reject a quarantined Content Library Item
+	contentLibraryItemRejectEndpoint = "contentLibraryItems/%s/reject"
+
+	contentLibraryItemStatusQuarantined      = "QUARANTINED"
+	contentLibraryItemStatusQuarantineExpire = "QUARANTINE_EXPIRED"
+	contentLibraryItemStatusRejected         = "REJECTED"
+
+	contentLibraryItemApprovalActionApprove = "APPROVE"
+	contentLibraryItemApprovalActionReject  = "REJECT"
+)
+
+// contentLibraryItemStatuses are the statuses that a Content Library Item can have
+var contentLibraryItemStatuses = []string{
+	"READY",
+	"NOT_READY",
+	"FAILED",
+	contentLibraryItemStatusQuarantined,
+	contentLibraryItemStatusQuarantineExpire,
+	contentLibraryItemStatusRejected,
+}
+
+// tmContentLibraryItemReview is the payload to approve or reject a quarantined Content Library Item
+type tmContentLibraryItemReview struct {
+	// Reason of the decision, which is shown to the owner of the Content Library Item
+	Reason string `json:"reason,omitempty"`
+}
+
+// getContentLibraryItemQuarantineState returns the quarantine state of a Content Library Item with the given status,
+// which is empty if the Content Library Item is not affected by quarantine
+func getContentLibraryItemQuarantineState(status string) string {
+	switch strings.ToUpper(status) {
+	case contentLibraryItemStatusQuarantined, contentLibraryItemStatusQuarantineExpire, contentLibraryItemStatusRejected:
+		return strings.ToUpper(status)
+	}
+	return ""
+}
+
+// isContentLibraryItemQuarantined returns true if a Content Library Item with the given status is waiting for approval
+func isContentLibraryItemQuarantined(status string) bool {
+	return strings.EqualFold(status, contentLibraryItemStatusQuarantined)
+}
+
+// reviewContentLibraryItem approves or rejects the given quarantined Content Library Item
+func reviewContentLibraryItem(tmClient *VCDClient, cli *govcd.ContentLibraryItem, action, reason string) error {
+	endpoint := contentLibraryItemApproveEndpoint
+	if action == contentLibraryItemApprovalActionReject {
+		endpoint = contentLibraryItemRejectEndpoint
+	}
+
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + fmt.Sprintf(endpoint, cli.ContentLibraryItem.ID))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s of quarantined %s '%s'", strings.ToLower(action), labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name)
+	task, err := client.OpenApiPostItemAsyncWithHeaders(client.APIVersion, urlRef, nil, &tmContentLibraryItemReview{Reason: reason}, getContentLibraryTenantHeaders(cli.ContentLibraryItem.Org))
+	if err != nil {
+		return fmt.Errorf("error performing %s of %s '%s': %s", action, labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+	}
+	err = task.WaitTaskCompletion()
+	if err != nil {
+		return fmt.Errorf("error waiting for %s of %s '%s': %s", action, labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+	}
+	return nil
+}
+
+// waitForContentLibraryItemQuarantineRelease waits until the given Content Library Item leaves quarantine, and then until
+// its upload finishes. It fails if the Content Library Item is rejected or its quarantine expires
+func waitForContentLibraryItemQuarantineRelease(ctx context.Context, tmClient *VCDClient, cli *govcd.ContentLibraryItem, timeout time.Duration) error {
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{contentLibraryItemStatusQuarantined},
+		Target:  []string{"RELEASED"},
+		Refresh: func() (any, string, error) {
+			current, err := tmClient.GetContentLibraryItemById(cli.ContentLibraryItem.ID)
+			if govcd.ContainsNotFound(err) {
+				return nil, "", fmt.Errorf("%s '%s' was removed while in quarantine", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name)
+			}
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := strings.ToUpper(current.ContentLibraryItem.Status)
+			log.Printf("[DEBUG] %s '%s' current status is %s", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, status)
+			switch status {
+			case contentLibraryItemStatusQuarantined:
+				return current, status, nil
+			case contentLibraryItemStatusRejected, contentLibraryItemStatusQuarantineExpire:
+				return nil, "", fmt.Errorf("%s '%s' did not pass quarantine, its status is %s", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, status)
+			}
+			return current, "RELEASED", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s '%s' to leave quarantine: %s", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+	}
+
+	// Once approved, VCFA finishes processing the uploaded files
+	return waitForContentLibraryItemUploadTask(tmClient, cli.ContentLibraryItem)
+}
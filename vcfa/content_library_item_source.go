@@ -0,0 +1,488 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const (
+	ociManifestMediaTypes = "application/vnd.oci.image.manifest.v1+json, application/vnd.docker.distribution.manifest.v2+json"
+	ociTitleAnnotation    = "org.opencontainers.image.title"
+)
+
+// The timeouts of the downloads of remote sources are variables, so unit tests can shorten them.
+var (
+	// sourceHttpConnectTimeout limits the time to connect to a source and to receive the headers of its answer
+	sourceHttpConnectTimeout = 60 * time.Second
+	// sourceHttpReadTimeout limits the time of every read of the body of a source, so that a stalled server fails the
+	// download. There is no limit for the whole download, as streaming large files can take hours.
+	sourceHttpReadTimeout = 5 * time.Minute
+)
+
+// errSourceReadTimeout is the cause of the cancellation of a download that did not receive data in time
+var errSourceReadTimeout = errors.New("no data received from the source in time")
+
+// sourceChecksumRegex validates the checksum of a remote source, which is a SHA-256 in hexadecimal format with an optional
+// 'sha256:' prefix
+var sourceChecksumRegex = regexp.MustCompile(`^(sha256:)?[a-fA-F0-9]{64}$`)
+
+// contentLibraryItemSource is a remote ISO or OVA that is streamed into a Content Library Item without storing it on disk
+type contentLibraryItemSource struct {
+	name             string // File name of the artifact, used to determine whether it is an ISO or an OVA
+	size             int64  // Size in bytes, or -1 if unknown. Only ISO files require it
+	body             io.ReadCloser
+	expectedChecksum string // Optional SHA-256 in hexadecimal format
+}
+
+// itemType returns the type of Content Library Item that the source creates
+func (src *contentLibraryItemSource) itemType() (string, error) {
+	switch strings.ToLower(filepath.Ext(src.name)) {
+	case ".iso":
+		if src.size <= 0 {
+			return "", fmt.Errorf("the size of the ISO file '%s' is required to upload it, but the server did not send it", src.name)
+		}
+		return "ISO", nil
+	case ".ova":
+		return "TEMPLATE", nil
+	}
+	return "", fmt.Errorf("the source '%s' must be an ISO or an OVA file", src.name)
+}
+
+func (src *contentLibraryItemSource) close() {
+	if err := src.body.Close(); err != nil {
+		log.Printf("[DEBUG] could not close the source '%s': %s", src.name, err)
+	}
+}
+
+// newContentLibraryItemSourceHttpClient returns the HTTP client that downloads remote sources, with the proxy settings of
+// the environment and the timeouts to connect to the source. Remote sources are usually not the VCFA server, so they don't
+// inherit 'allow_unverified_ssl' from the provider: their certificates are only left unverified when allowUnverifiedSsl is set.
+func newContentLibraryItemSourceHttpClient(allowUnverifiedSsl bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if allowUnverifiedSsl {
+		// #nosec G402 -- InsecureSkipVerify: explicitly requested with 'source_allow_unverified_ssl'
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	transport.DialContext = (&net.Dialer{Timeout: sourceHttpConnectTimeout}).DialContext
+	transport.TLSHandshakeTimeout = sourceHttpConnectTimeout
+	transport.ResponseHeaderTimeout = sourceHttpConnectTimeout
+	return &http.Client{Transport: transport}
+}
+
+// openContentLibraryItemUrlSource starts the download of the given HTTP(S) URL, sending the given headers
+func openContentLibraryItemUrlSource(client *http.Client, sourceUrl string, headers map[string]string, checksum string) (*contentLibraryItemSource, error) {
+	resp, err := sourceHttpGet(client, sourceUrl, headers, "")
+	if err != nil {
+		return nil, err
+	}
+	name := path.Base(resp.Request.URL.Path)
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+		name = params["filename"]
+	}
+
+	return &contentLibraryItemSource{
+		name:             name,
+		size:             resp.ContentLength,
+		body:             resp.Body,
+		expectedChecksum: strings.ToLower(strings.TrimPrefix(checksum, "sha256:")),
+	}, nil
+}
+
+// openContentLibraryItemOciSource starts the download of the ISO or OVA stored as a layer of the given OCI artifact. The
+// reference has the format '<registry>/<repository>:<tag>' or '<registry>/<repository>@<digest>'. The digest of the layer
+// is used as the checksum of the source.
+func openContentLibraryItemOciSource(client *http.Client, reference string, headers map[string]string) (*contentLibraryItemSource, error) {
+	registry, repository, tagOrDigest, err := parseOciReference(reference)
+	if err != nil {
+		return nil, err
+	}
+	scheme := "https"
+	if host := strings.Split(registry, ":")[0]; host == "localhost" || host == "127.0.0.1" {
+		scheme = "http"
+	}
+	baseUrl := fmt.Sprintf("%s://%s/v2/%s", scheme, registry, repository)
+
+	resp, err := sourceHttpGet(client, baseUrl+"/manifests/"+tagOrDigest, headers, ociManifestMediaTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(resp)
+
+	var manifest struct {
+		Layers []struct {
+			MediaType   string            `json:"mediaType"`
+			Digest      string            `json:"digest"`
+			Size        int64             `json:"size"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"layers"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("error decoding the manifest of '%s': %s", reference, err)
+	}
+
+	// The layer is chosen by its title, which is the file name set by tools like ORAS when pushing the artifact
+	for _, layer := range manifest.Layers {
+		title := layer.Annotations[ociTitleAnnotation]
+		ext := strings.ToLower(filepath.Ext(title))
+		if ext != ".iso" && ext != ".ova" {
+			continue
+		}
+		if !strings.HasPrefix(layer.Digest, "sha256:") {
+			return nil, fmt.Errorf("the layer '%s' of '%s' has an unsupported digest '%s'", title, reference, layer.Digest)
+		}
+		blob, err := sourceHttpGet(client, baseUrl+"/blobs/"+layer.Digest, headers, "")
+		if err != nil {
+			return nil, err
+		}
+		return &contentLibraryItemSource{
+			name:             title,
+			size:             layer.Size,
+			body:             blob.Body,
+			expectedChecksum: strings.TrimPrefix(layer.Digest, "sha256:"),
+		}, nil
+	}
+	return nil, fmt.Errorf("could not find any layer with an ISO or OVA file in '%s'. The layer must have the '%s' annotation", reference, ociTitleAnnotation)
+}
+
+// parseOciReference splits an OCI reference in its registry, repository and tag or digest. The registry must be explicit.
+func parseOciReference(reference string) (string, string, string, error) {
+	ref := strings.TrimPrefix(reference, "oci://")
+	registry, repository, found := strings.Cut(ref, "/")
+	if !found || repository == "" || (!strings.ContainsAny(registry, ".:") && registry != "localhost") {
+		return "", "", "", fmt.Errorf("the OCI reference '%s' must have the format '<registry>/<repository>:<tag>' or '<registry>/<repository>@<digest>'", reference)
+	}
+
+	if repo, digest, found := strings.Cut(repository, "@"); found {
+		return registry, repo, digest, nil
+	}
+	tag := "latest"
+	if i := strings.LastIndex(repository, ":"); i > 0 {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	return registry, repository, tag, nil
+}
+
+// sourceHttpGet performs a GET request with the given headers. When the server answers with a Bearer challenge, as OCI
+// registries do, it obtains a token from the given realm and repeats the request with it.
+// The download is cancelled when a read of the body of the response takes longer than sourceHttpReadTimeout.
+func sourceHttpGet(client *http.Client, rawUrl string, headers map[string]string, accept string) (*http.Response, error) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	newRequest := func(token string) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return req, nil
+	}
+
+	resp, err := sourceHttpDo(ctx, client, newRequest, rawUrl, headers)
+	if err != nil {
+		cancel(nil)
+		return nil, err
+	}
+	resp.Body = newSourceReadTimeoutBody(ctx, cancel, resp.Body)
+	return resp, nil
+}
+
+// sourceHttpDo performs the request built by 'newRequest', authenticating with a Bearer token when the server asks for it
+func sourceHttpDo(ctx context.Context, client *http.Client, newRequest func(token string) (*http.Request, error), rawUrl string, headers map[string]string) (*http.Response, error) {
+	req, err := newRequest("")
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading '%s': %s", rawUrl, err)
+	}
+	if resp.StatusCode == http.StatusUnauthorized && strings.HasPrefix(resp.Header.Get("WWW-Authenticate"), "Bearer ") {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		closeResponseBody(resp)
+		token, err := getBearerToken(ctx, client, challenge, headers)
+		if err != nil {
+			return nil, fmt.Errorf("error authenticating to download '%s': %s", rawUrl, err)
+		}
+		req, err = newRequest(token)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error downloading '%s': %s", rawUrl, err)
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		closeResponseBody(resp)
+		return nil, fmt.Errorf("error downloading '%s': got HTTP status %s", rawUrl, resp.Status)
+	}
+	return resp, nil
+}
+
+// sourceReadTimeoutBody is the body of the download of a source. Every read that takes longer than
+// sourceHttpReadTimeout cancels the download. The time between reads is not limited, as the upload to VCFA can be slower
+// than the download.
+type sourceReadTimeoutBody struct {
+	body   io.ReadCloser
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	timer  *time.Timer
+}
+
+func newSourceReadTimeoutBody(ctx context.Context, cancel context.CancelCauseFunc, body io.ReadCloser) *sourceReadTimeoutBody {
+	timer := time.AfterFunc(sourceHttpReadTimeout, func() {
+		cancel(errSourceReadTimeout)
+	})
+	timer.Stop()
+	return &sourceReadTimeoutBody{body: body, ctx: ctx, cancel: cancel, timer: timer}
+}
+
+func (b *sourceReadTimeoutBody) Read(p []byte) (int, error) {
+	b.timer.Reset(sourceHttpReadTimeout)
+	n, err := b.body.Read(p)
+	b.timer.Stop()
+	if err != nil && errors.Is(context.Cause(b.ctx), errSourceReadTimeout) {
+		return n, fmt.Errorf("%w after %s", errSourceReadTimeout, sourceHttpReadTimeout)
+	}
+	return n, err
+}
+
+func (b *sourceReadTimeoutBody) Close() error {
+	b.timer.Stop()
+	b.cancel(nil)
+	return b.body.Close()
+}
+
+// getBearerToken obtains a token from the realm of the given 'WWW-Authenticate: Bearer' challenge. The given headers are
+// sent to the realm, so credentials like a Basic 'Authorization' header are used to get the token.
+func getBearerToken(ctx context.Context, client *http.Client, challenge string, headers map[string]string) (string, error) {
+	params := map[string]string{}
+	for _, match := range regexp.MustCompile(`(\w+)="([^"]*)"`).FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+	if params["realm"] == "" {
+		return "", fmt.Errorf("no realm found in the challenge '%s'", challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", err
+	}
+	query := realm.Query()
+	for _, p := range []string{"service", "scope"} {
+		if params[p] != "" {
+			query.Set(p, params[p])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	// The token is small, so the whole request is limited
+	ctx, cancel := context.WithTimeout(ctx, sourceHttpReadTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer closeResponseBody(resp)
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("got HTTP status %s from '%s'", resp.Status, realm.Host)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	if token.Token != "" {
+		return token.Token, nil
+	}
+	return token.AccessToken, nil
+}
+
+func closeResponseBody(resp *http.Response) {
+	if err := resp.Body.Close(); err != nil {
+		log.Printf("[DEBUG] could not close response body: %s", err)
+	}
+}
+
+// createContentLibraryItemFromSource creates a Content Library Item with the content of the given source, returning
+// the calculated checksum of the source
+func createContentLibraryItemFromSource(tmClient *VCDClient, cl *govcd.ContentLibrary, config *types.ContentLibraryItem, src *contentLibraryItemSource, opts contentLibraryItemUploadOptions) (*govcd.ContentLibraryItem, string, error) {
+	itemType, err := src.itemType()
+	if err != nil {
+		return nil, "", err
+	}
+	config.ItemType = itemType
+	if itemType == "ISO" {
+		config.FileUploadSizeBytes = src.size
+	}
+
+	checksum := ""
+	cli, err := createContentLibraryItemWithUpload(tmClient, cl, config, func(created *types.ContentLibraryItem) error {
+		checksum, err = uploadContentLibraryItemSource(tmClient, created, src, opts)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return cli, checksum, nil
+}
+
+// uploadContentLibraryItemNewVersionFromSource uploads the content of the given source as a new version of an existing
+// Content Library Item
+func uploadContentLibraryItemNewVersionFromSource(tmClient *VCDClient, cli *govcd.ContentLibraryItem, src *contentLibraryItemSource, opts contentLibraryItemUploadOptions) (string, error) {
+	itemType, err := src.itemType()
+	if err != nil {
+		return "", err
+	}
+	if itemType != cli.ContentLibraryItem.ItemType {
+		return "", fmt.Errorf("the source '%s' is a %s, but %s '%s' is a %s", src.name, itemType, labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, cli.ContentLibraryItem.ItemType)
+	}
+	var fileUploadSizeBytes int64
+	if itemType == "ISO" {
+		fileUploadSizeBytes = src.size
+	}
+	err = startContentLibraryItemVersionUpload(tmClient, cli.ContentLibraryItem, fileUploadSizeBytes)
+	if err != nil {
+		return "", err
+	}
+
+	checksum, err := uploadContentLibraryItemSource(tmClient, cli.ContentLibraryItem, src, opts)
+	if err != nil {
+		return "", err
+	}
+	return checksum, waitForContentLibraryItemUploadTask(tmClient, cli.ContentLibraryItem)
+}
+
+// uploadContentLibraryItemSource streams the source into the files requested by VCFA. The SHA-256 checksum of the whole
+// source is calculated while reading it, and verified before the last chunk is sent, so a corrupted source never
+// finishes the upload. Returns the calculated checksum.
+func uploadContentLibraryItemSource(tmClient *VCDClient, cli *types.ContentLibraryItem, src *contentLibraryItemSource, opts contentLibraryItemUploadOptions) (string, error) {
+	client := &tmClient.VCDClient.Client
+	h := sha256.New()
+	reader := io.TeeReader(src.body, h)
+	checksum := ""
+	verify := func() error {
+		// The remaining bytes of the source (i.e. the end of an OVA) are not uploaded, but are part of the checksum
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return fmt.Errorf("error reading the source '%s': %s", src.name, err)
+		}
+		checksum = hex.EncodeToString(h.Sum(nil))
+		return verifySourceChecksum(src, checksum)
+	}
+
+	files, err := getContentLibraryItemPendingFiles(tmClient, cli, 1)
+	if err != nil {
+		return "", err
+	}
+
+	if cli.ItemType == "ISO" {
+		err = uploadContentLibraryItemStream(client, files[0], reader, src.size, opts, verify)
+		if err != nil {
+			return "", err
+		}
+		return checksum, nil
+	}
+
+	// OVA files are TAR files with the OVF descriptor first, followed by the files that it references
+	tarReader := tar.NewReader(reader)
+	header, err := tarReader.Next()
+	if err != nil {
+		return "", fmt.Errorf("error reading the OVA '%s': %s", src.name, err)
+	}
+	if filepath.Ext(header.Name) != ".ovf" {
+		return "", fmt.Errorf("the first file of the OVA '%s' must be the OVF descriptor, got '%s'", src.name, header.Name)
+	}
+	err = uploadContentLibraryItemStream(client, files[0], tarReader, header.Size, opts, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// Once the OVF descriptor is uploaded, VCFA requests the files it references. Files that were completely uploaded
+	// by a previous, interrupted, run are streamed too, as they are part of the checksum
+	files, err = getContentLibraryItemPendingFiles(tmClient, cli, 2)
+	if err != nil {
+		return "", err
+	}
+	pending := map[string]*types.ContentLibraryItemFile{}
+	for _, file := range files {
+		if filepath.Ext(file.Name) != ".ovf" {
+			pending[file.Name] = file
+		}
+	}
+	for len(pending) > 0 {
+		header, err = tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			missing := make([]string, 0, len(pending))
+			for name := range pending {
+				missing = append(missing, name)
+			}
+			return "", fmt.Errorf("the OVA '%s' does not contain the files %v referenced by its OVF descriptor", src.name, missing)
+		}
+		if err != nil {
+			return "", fmt.Errorf("error reading the OVA '%s': %s", src.name, err)
+		}
+		file, ok := pending[path.Base(header.Name)]
+		if !ok {
+			continue
+		}
+		delete(pending, file.Name)
+		var beforeLastChunk func() error
+		if len(pending) == 0 {
+			beforeLastChunk = verify
+		}
+		err = uploadContentLibraryItemStream(client, file, tarReader, header.Size, opts, beforeLastChunk)
+		if err != nil {
+			return "", err
+		}
+	}
+	return checksum, nil
+}
+
+// verifySourceChecksum compares the calculated checksum with the expected one, when the source has any
+func verifySourceChecksum(src *contentLibraryItemSource, checksum string) error {
+	if src.expectedChecksum == "" || src.expectedChecksum == checksum {
+		return nil
+	}
+	return fmt.Errorf("the SHA-256 checksum of '%s' is '%s', but '%s' was expected", src.name, checksum, src.expectedChecksum)
+}
@@ -0,0 +1,133 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setupSourceUnitTest shortens the timeouts of the downloads of remote sources
+func setupSourceUnitTest(t *testing.T) {
+	connectTimeout, readTimeout := sourceHttpConnectTimeout, sourceHttpReadTimeout
+	sourceHttpConnectTimeout, sourceHttpReadTimeout = 200*time.Millisecond, 200*time.Millisecond
+	t.Cleanup(func() {
+		sourceHttpConnectTimeout, sourceHttpReadTimeout = connectTimeout, readTimeout
+	})
+}
+
+func TestSourceHttpGetVerifiesCertificates(t *testing.T) {
+	setupSourceUnitTest(t)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "content")
+	}))
+	defer server.Close()
+
+	_, err := sourceHttpGet(newContentLibraryItemSourceHttpClient(false), server.URL+"/image.iso", nil, "")
+	if err == nil || !strings.Contains(err.Error(), "certificate") {
+		t.Fatalf("expected a certificate error without 'source_allow_unverified_ssl', got %v", err)
+	}
+
+	resp, err := sourceHttpGet(newContentLibraryItemSourceHttpClient(true), server.URL+"/image.iso", nil, "")
+	if err != nil {
+		t.Fatalf("expected the self-signed certificate to be accepted with 'source_allow_unverified_ssl', got %s", err)
+	}
+	content, err := io.ReadAll(resp.Body)
+	closeResponseBody(resp)
+	if err != nil || string(content) != "content" {
+		t.Fatalf("expected to read 'content', got %q (error: %v)", content, err)
+	}
+}
+
+func TestSourceHttpGetTimeouts(t *testing.T) {
+	setupSourceUnitTest(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stalled-headers":
+			<-r.Context().Done()
+			return
+		case "/stalled-body":
+			_, _ = fmt.Fprint(w, "partial")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		_, _ = fmt.Fprint(w, "content")
+	}))
+	defer server.Close()
+	client := newContentLibraryItemSourceHttpClient(false)
+
+	t.Run("StalledHeaders", func(t *testing.T) {
+		_, err := sourceHttpGet(client, server.URL+"/stalled-headers", nil, "")
+		if err == nil || !strings.Contains(err.Error(), "timeout") {
+			t.Fatalf("expected a timeout error, got %v", err)
+		}
+	})
+
+	t.Run("StalledBody", func(t *testing.T) {
+		resp, err := sourceHttpGet(client, server.URL+"/stalled-body", nil, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer closeResponseBody(resp)
+		_, err = io.ReadAll(resp.Body)
+		if !errors.Is(err, errSourceReadTimeout) {
+			t.Fatalf("expected the read timeout error, got %v", err)
+		}
+	})
+
+	// The time between reads is spent uploading to VCFA, so it must not cancel the download
+	t.Run("SlowConsumer", func(t *testing.T) {
+		resp, err := sourceHttpGet(client, server.URL+"/image.iso", nil, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer closeResponseBody(resp)
+		time.Sleep(2 * sourceHttpReadTimeout)
+		content, err := io.ReadAll(resp.Body)
+		if err != nil || string(content) != "content" {
+			t.Fatalf("expected to read 'content', got %q (error: %v)", content, err)
+		}
+	})
+}
+
+func TestSourceHttpGetBearerToken(t *testing.T) {
+	setupSourceUnitTest(t)
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			if r.URL.Query().Get("scope") != "repository:isos:pull" || r.Header.Get("Authorization") != "Basic dXNlcjpwYXNz" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"token": "secret-token"}`)
+		case r.Header.Get("Authorization") == "Bearer secret-token":
+			_, _ = fmt.Fprint(w, "content")
+		default:
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:isos:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	resp, err := sourceHttpGet(newContentLibraryItemSourceHttpClient(false), server.URL+"/v2/isos/blobs/sha256:1", map[string]string{"Authorization": "Basic dXNlcjpwYXNz"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(resp.Body)
+	closeResponseBody(resp)
+	if err != nil || string(content) != "content" {
+		t.Fatalf("expected to read 'content', got %q (error: %v)", content, err)
+	}
+}
@@ -0,0 +1,811 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+const (
+	// contentLibraryItemVersionsEndpoint is used to start the upload of a new version of an existing Content Library Item
+	contentLibraryItemVersionsEndpoint = "contentLibraryItems/%s/versions"
+
+	// contentLibraryItemFilesPollingRetries is the amount of times that the pending files of a Content Library Item are
+	// requested before giving up
+	contentLibraryItemFilesPollingRetries = 10
+	contentLibraryItemFilesPollingDelay   = 10 * time.Second
+
+	// contentLibraryItemChunkRetries is the amount of times that a failed chunk is sent again, waiting an exponential
+	// backoff between attempts that starts in contentLibraryItemChunkBackoff and is capped to contentLibraryItemChunkMaxBackoff
+	contentLibraryItemChunkRetries = 5
+)
+
+// The backoffs between chunk retries are variables, so unit tests can shorten them
+var (
+	contentLibraryItemChunkBackoff    = 2 * time.Second
+	contentLibraryItemChunkMaxBackoff = 1 * time.Minute
+)
+
+// contentLibraryItemUploadOptions defines how the files of a Content Library Item are uploaded
+type contentLibraryItemUploadOptions struct {
+	pieceSize        int64         // Size in bytes of every chunk
+	parallelism      int           // Amount of chunks that are uploaded at the same time
+	progressInterval time.Duration // How often the upload progress is logged
+	journalDirectory string        // Directory where the journals of interrupted uploads are kept
+}
+
+// getContentLibraryItemUploadOptions reads the upload options from the resource arguments
+func getContentLibraryItemUploadOptions(d *schema.ResourceData) contentLibraryItemUploadOptions {
+	return contentLibraryItemUploadOptions{
+		pieceSize:        int64(d.Get("upload_piece_size").(int)) * 1024 * 1024,
+		parallelism:      d.Get("upload_parallelism").(int),
+		progressInterval: time.Duration(d.Get("upload_progress_interval_seconds").(int)) * time.Second,
+		journalDirectory: d.Get("upload_journal_directory").(string),
+	}
+}
+
+// contentLibraryItemTransferError is an error sending the content of a file to VCFA. The upload session is kept
+// when it happens, so it can be resumed later.
+type contentLibraryItemTransferError struct {
+	err error
+}
+
+func (e *contentLibraryItemTransferError) Error() string {
+	return e.err.Error()
+}
+
+// contentLibraryItemChunkError is an error response received when sending a chunk
+type contentLibraryItemChunkError struct {
+	statusCode int
+	message    string
+}
+
+func (e *contentLibraryItemChunkError) Error() string {
+	return e.message
+}
+
+// retryable returns true if the chunk can be sent again after this error
+func (e *contentLibraryItemChunkError) retryable() bool {
+	return e.statusCode >= 500 || e.statusCode == http.StatusRequestTimeout || e.statusCode == http.StatusTooManyRequests
+}
+
+// contentLibraryItemChecksums calculates the SHA-256 checksum of every given file, indexed by the file name. The
+// name is used instead of the full path so moving a file to another directory is not considered a content change.
+func contentLibraryItemChecksums(filePaths []string) (map[string]string, error) {
+	checksums := make(map[string]string, len(filePaths))
+	for _, p := range filePaths {
+		checksum, err := fileSha256(p)
+		if err != nil {
+			return nil, fmt.Errorf("could not calculate the checksum of '%s': %s", p, err)
+		}
+		checksums[filepath.Base(p)] = checksum
+	}
+	return checksums, nil
+}
+
+// contentLibraryItemFingerprints returns the size and modification time of every given file, indexed by the file name,
+// so the checksums are only calculated again when they change. The paths that do not exist are returned separately.
+func contentLibraryItemFingerprints(filePaths []string) (map[string]string, []string, error) {
+	fingerprints := make(map[string]string, len(filePaths))
+	var missing []string
+	for _, p := range filePaths {
+		fileInfo, err := os.Stat(p)
+		if os.IsNotExist(err) {
+			missing = append(missing, p)
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read '%s': %s", p, err)
+		}
+		fingerprints[filepath.Base(p)] = fileFingerprint(fileInfo)
+	}
+	return fingerprints, missing, nil
+}
+
+// fileFingerprint returns the size and modification time of a file, which change when its contents change
+func fileFingerprint(fileInfo os.FileInfo) string {
+	return fmt.Sprintf("%d-%d", fileInfo.Size(), fileInfo.ModTime().UnixNano())
+}
+
+// fingerprintsEqual returns true if the fingerprints stored in state are the same as the given ones
+func fingerprintsEqual(stored map[string]interface{}, fingerprints map[string]string) bool {
+	if len(stored) != len(fingerprints) {
+		return false
+	}
+	for k, v := range fingerprints {
+		if stored[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// fileSha256 returns the hexadecimal SHA-256 checksum of the file located in the given path
+func fileSha256(filePath string) (string, error) {
+	f, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("[DEBUG] could not close file '%s': %s", filePath, err)
+		}
+	}()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// checksumsContentEqual returns true if both checksum maps describe the same file contents, regardless of the file names
+func checksumsContentEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	valuesA, valuesB := make([]string, 0, len(a)), make([]string, 0, len(b))
+	for _, v := range a {
+		valuesA = append(valuesA, fmt.Sprintf("%v", v))
+	}
+	for _, v := range b {
+		valuesB = append(valuesB, fmt.Sprintf("%v", v))
+	}
+	sort.Strings(valuesA)
+	sort.Strings(valuesB)
+	for i := range valuesA {
+		if valuesA[i] != valuesB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// createContentLibraryItemWithUpload creates a Content Library Item with the given configuration and uploads its content
+// with the given function. If there is already a Content Library Item with the same name with an upload in progress,
+// because a previous run was interrupted, its upload is resumed instead.
+// When the content cannot be transferred, the Content Library Item is kept so the upload can be resumed in the next
+// run. On any other error, it is removed so it is not left stranded.
+func createContentLibraryItemWithUpload(tmClient *VCDClient, cl *govcd.ContentLibrary, config *types.ContentLibraryItem, upload func(cli *types.ContentLibraryItem) error) (*govcd.ContentLibraryItem, error) {
+	created, err := findResumableContentLibraryItem(tmClient, cl, config.Name)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		created, err = createContentLibraryItemSkeleton(tmClient, cl, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = upload(created)
+	if err == nil {
+		err = waitForContentLibraryItemUploadTask(tmClient, created)
+	}
+	var transferErr *contentLibraryItemTransferError
+	if errors.As(err, &transferErr) {
+		return nil, fmt.Errorf("%s. The upload of %s '%s' is kept in progress and will be resumed in the next run", err, labelVcfaContentLibraryItem, created.Name)
+	}
+	if err != nil {
+		return nil, cleanupContentLibraryItemOnUploadError(tmClient, cl, created.ID, err)
+	}
+
+	return cl.GetContentLibraryItemById(created.ID)
+}
+
+// findResumableContentLibraryItem returns the Content Library Item with the given name if it has an upload in progress,
+// or nil otherwise
+func findResumableContentLibraryItem(tmClient *VCDClient, cl *govcd.ContentLibrary, name string) (*types.ContentLibraryItem, error) {
+	cli, err := cl.GetContentLibraryItemByName(name)
+	if govcd.ContainsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error checking whether %s '%s' has an upload in progress: %s", labelVcfaContentLibraryItem, name, err)
+	}
+	_, err = getContentLibraryItemUploadTask(tmClient, cli.ContentLibraryItem)
+	if errors.Is(err, errNoContentLibraryItemUploadTask) {
+		// Not an interrupted upload. The creation will fail as the name is already taken
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] resuming the upload in progress of %s '%s'", labelVcfaContentLibraryItem, name)
+	return cli.ContentLibraryItem, nil
+}
+
+// createContentLibraryItemSkeleton creates an empty Content Library Item, which content must be uploaded afterward
+func createContentLibraryItemSkeleton(tmClient *VCDClient, cl *govcd.ContentLibrary, config *types.ContentLibraryItem) (*types.ContentLibraryItem, error) {
+	config.ContentLibrary = types.OpenApiReference{Name: cl.ContentLibrary.Name, ID: cl.ContentLibrary.ID}
+
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + types.OpenApiEndpointContentLibraryItems)
+	if err != nil {
+		return nil, err
+	}
+	created := &types.ContentLibraryItem{}
+	err = client.OpenApiPostItem(client.APIVersion, urlRef, nil, config, created, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s '%s': %s", labelVcfaContentLibraryItem, config.Name, err)
+	}
+	return created, nil
+}
+
+// cleanupContentLibraryItemOnUploadError removes the Content Library Item that could not be uploaded, so it is not left
+// stranded. It cancels the upload task if it is still running, which makes VCFA remove the item.
+func cleanupContentLibraryItemOnUploadError(tmClient *VCDClient, cl *govcd.ContentLibrary, id string, originalError error) error {
+	cli, err := cl.GetContentLibraryItemById(id)
+	if govcd.ContainsNotFound(err) {
+		return originalError
+	}
+	if err == nil {
+		err = cancelContentLibraryItemUploadTask(tmClient, cli.ContentLibraryItem)
+		if errors.Is(err, errNoContentLibraryItemUploadTask) {
+			err = cli.Delete()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("the %s upload failed with error: %s\nCleanup of stranded %s also failed: %s", labelVcfaContentLibraryItem, originalError, labelVcfaContentLibraryItem, err)
+	}
+	return originalError
+}
+
+// createContentLibraryItemFromFiles creates a Content Library Item with the given local files
+func createContentLibraryItemFromFiles(tmClient *VCDClient, cl *govcd.ContentLibrary, config *types.ContentLibraryItem, args govcd.ContentLibraryItemUploadArguments, opts contentLibraryItemUploadOptions) (*govcd.ContentLibraryItem, error) {
+	config.ItemType = "TEMPLATE"
+	if filepath.Ext(args.FilePath) == ".iso" {
+		fileInfo, err := os.Stat(args.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		config.ItemType = "ISO"
+		config.FileUploadSizeBytes = fileInfo.Size()
+	}
+
+	return createContentLibraryItemWithUpload(tmClient, cl, config, func(cli *types.ContentLibraryItem) error {
+		return uploadContentLibraryItemLocalFiles(tmClient, cli, args, opts)
+	})
+}
+
+// uploadContentLibraryItemNewVersion uploads the given files as a new version of an existing Content Library Item. The
+// item keeps its ID, and VCFA increases its version once the upload task finishes.
+func uploadContentLibraryItemNewVersion(tmClient *VCDClient, cli *govcd.ContentLibraryItem, args govcd.ContentLibraryItemUploadArguments, opts contentLibraryItemUploadOptions) error {
+	var fileUploadSizeBytes int64
+	if filepath.Ext(args.FilePath) == ".iso" {
+		fileInfo, err := os.Stat(args.FilePath)
+		if err != nil {
+			return err
+		}
+		fileUploadSizeBytes = fileInfo.Size()
+	}
+	err := startContentLibraryItemVersionUpload(tmClient, cli.ContentLibraryItem, fileUploadSizeBytes)
+	if err != nil {
+		return err
+	}
+
+	err = uploadContentLibraryItemLocalFiles(tmClient, cli.ContentLibraryItem, args, opts)
+	if err != nil {
+		return err
+	}
+	return waitForContentLibraryItemUploadTask(tmClient, cli.ContentLibraryItem)
+}
+
+// uploadContentLibraryItemLocalFiles uploads the given local files into a Content Library Item that is waiting for them
+func uploadContentLibraryItemLocalFiles(tmClient *VCDClient, cli *types.ContentLibraryItem, args govcd.ContentLibraryItemUploadArguments, opts contentLibraryItemUploadOptions) error {
+	// OVA files have all the required files packed inside, so they are uploaded the same way as OVF files
+	if filepath.Ext(args.FilePath) == ".ova" {
+		ovaInnerFilesPaths, tmpDir, err := util.Unpack(args.FilePath)
+		if err != nil {
+			return fmt.Errorf("%s. Unpacked files for checking are accessible in: %s", err, tmpDir)
+		}
+		defer func() {
+			if err := os.RemoveAll(tmpDir); err != nil {
+				log.Printf("[DEBUG] could not clean up tmp directory %s", tmpDir)
+			}
+		}()
+		args.FilePath = ""
+		for _, p := range ovaInnerFilesPaths {
+			if filepath.Ext(p) == ".ovf" {
+				args.FilePath = p
+			} else {
+				args.OvfFilesPaths = append(args.OvfFilesPaths, p)
+			}
+		}
+		if args.FilePath == "" {
+			return fmt.Errorf("could not find any OVF descriptor inside the OVA file")
+		}
+	}
+
+	client := &tmClient.VCDClient.Client
+	// The first file requested is always the ISO file or the OVF descriptor
+	files, err := getContentLibraryItemPendingFiles(tmClient, cli, 1)
+	if err != nil {
+		return err
+	}
+	err = uploadContentLibraryItemFiles(client, files, args, opts)
+	if err != nil {
+		return err
+	}
+
+	if cli.ItemType == "TEMPLATE" {
+		// Once the OVF descriptor is uploaded, VCFA requests the files it references
+		files, err = getContentLibraryItemPendingFiles(tmClient, cli, 2)
+		if err != nil {
+			return err
+		}
+		err = uploadContentLibraryItemFiles(client, files, args, opts)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startContentLibraryItemVersionUpload requests VCFA to start the upload of a new version of the given Content Library
+// Item. ISO files require the size of the file to upload. If there is an upload in progress, because a previous run was
+// interrupted, it is resumed instead.
+func startContentLibraryItemVersionUpload(tmClient *VCDClient, cli *types.ContentLibraryItem, fileUploadSizeBytes int64) error {
+	_, err := getContentLibraryItemUploadTask(tmClient, cli)
+	if err == nil {
+		log.Printf("[DEBUG] resuming the upload in progress of %s '%s'", labelVcfaContentLibraryItem, cli.Name)
+		return nil
+	}
+	if !errors.Is(err, errNoContentLibraryItemUploadTask) {
+		return err
+	}
+
+	payload := &types.ContentLibraryItem{
+		Name:                cli.Name,
+		ItemType:            cli.ItemType,
+		ContentLibrary:      cli.ContentLibrary,
+		FileUploadSizeBytes: fileUploadSizeBytes,
+	}
+
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf+contentLibraryItemVersionsEndpoint, cli.ID)
+	if err != nil {
+		return fmt.Errorf("error building endpoint to upload a new version of %s '%s': %s", labelVcfaContentLibraryItem, cli.Name, err)
+	}
+	err = client.OpenApiPostItem(client.APIVersion, urlRef, nil, payload, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error starting the upload of a new version of %s '%s': %s", labelVcfaContentLibraryItem, cli.Name, err)
+	}
+	return nil
+}
+
+// uploadContentLibraryItemFiles uploads the requested files, looking for them in the given upload arguments. Files that
+// were partially uploaded by a previous run are resumed.
+func uploadContentLibraryItemFiles(client *govcd.Client, files []*types.ContentLibraryItemFile, args govcd.ContentLibraryItemUploadArguments, opts contentLibraryItemUploadOptions) error {
+	for _, file := range files {
+		if file.BytesTransferred != 0 && file.ExpectedSizeBytes == file.BytesTransferred {
+			continue
+		}
+		localPath, err := findContentLibraryItemFileLocalPath(file.Name, args)
+		if err != nil {
+			return err
+		}
+		err = uploadContentLibraryItemFile(client, file, localPath, opts)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findContentLibraryItemFileLocalPath returns the local path of the file that VCFA requests with the given name
+func findContentLibraryItemFileLocalPath(name string, args govcd.ContentLibraryItemUploadArguments) (string, error) {
+	// VCFA may rename the ISO file or the OVF descriptor, so these are matched by extension
+	if ext := filepath.Ext(name); ext == ".iso" || ext == ".ovf" {
+		if filepath.Ext(args.FilePath) == ext {
+			return args.FilePath, nil
+		}
+	}
+	for _, p := range append([]string{args.FilePath}, args.OvfFilesPaths...) {
+		if filepath.Base(p) == name {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("'%s' not found among the local file paths: %v", name, append([]string{args.FilePath}, args.OvfFilesPaths...))
+}
+
+// uploadContentLibraryItemFile uploads the file located in the given path to the transfer URL provided by VCFA
+func uploadContentLibraryItemFile(client *govcd.Client, file *types.ContentLibraryItemFile, localPath string, opts contentLibraryItemUploadOptions) error {
+	f, err := os.Open(filepath.Clean(localPath))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("[DEBUG] could not close file '%s': %s", localPath, err)
+		}
+	}()
+
+	fileInfo, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return uploadContentLibraryItemStream(client, file, f, fileInfo.Size(), opts, nil)
+}
+
+// uploadContentLibraryItemStream reads 'totalSize' bytes from the given reader and uploads them to the transfer URL
+// provided by VCFA, in chunks that are sent in parallel. The chunks that VCFA already received are skipped, so
+// interrupted uploads are resumed, see getContentLibraryItemResumeJournal.
+// If 'beforeLastChunk' is set, it is called before sending the last chunk, once all the others are sent, so the upload
+// is not finished by VCFA when it returns an error.
+func uploadContentLibraryItemStream(client *govcd.Client, file *types.ContentLibraryItemFile, reader io.Reader, totalSize int64, opts contentLibraryItemUploadOptions, beforeLastChunk func() error) error {
+	if file.ExpectedSizeBytes > 0 && file.ExpectedSizeBytes != totalSize {
+		return fmt.Errorf("the size of the source (%d bytes) does not match the size expected by VCFA for '%s' (%d bytes)", totalSize, file.Name, file.ExpectedSizeBytes)
+	}
+	pieceSize := opts.pieceSize
+	if pieceSize <= 0 {
+		pieceSize = 1024 * 1024
+	}
+	parallelism := max(opts.parallelism, 1)
+
+	journal, serial := getContentLibraryItemResumeJournal(file, totalSize, opts.journalDirectory)
+	if serial {
+		parallelism = 1
+	}
+	pending := journal.pendingChunks(pieceSize)
+
+	progress := newTransferProgress("Uploaded", file.Name, journal.completedBytes(), totalSize, opts.progressInterval)
+	defer progress.stop()
+
+	type chunk struct {
+		data   []byte
+		offset int64
+	}
+	chunks := make(chan chunk)
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var uploadErr error
+	failed := make(chan struct{})
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chunks {
+				err := uploadContentLibraryItemChunkWithRetries(client, file, c.data, c.offset, totalSize)
+				if err != nil {
+					errOnce.Do(func() {
+						uploadErr = err
+						close(failed)
+					})
+					continue
+				}
+				journal.add(c.offset, c.offset+int64(len(c.data)))
+				progress.add(int64(len(c.data)))
+			}
+		}()
+	}
+	// waitForChunks stops the workers and returns the first error that they found
+	waitForChunks := func() error {
+		close(chunks)
+		wg.Wait()
+		return uploadErr
+	}
+
+	// position is the amount of bytes read from the reader. The chunks that VCFA already received are skipped
+	position := int64(0)
+	for i, pendingChunk := range pending {
+		if err := skipBytes(reader, pendingChunk.Start-position); err != nil {
+			_ = waitForChunks()
+			return fmt.Errorf("error skipping the uploaded content of %s file '%s': %s", labelVcfaContentLibraryItem, file.Name, err)
+		}
+		data := make([]byte, pendingChunk.End-pendingChunk.Start)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			_ = waitForChunks()
+			return fmt.Errorf("error reading the content of %s file '%s': %s", labelVcfaContentLibraryItem, file.Name, err)
+		}
+		position = pendingChunk.End
+
+		if i < len(pending)-1 {
+			select {
+			case chunks <- chunk{data: data, offset: pendingChunk.Start}:
+			case <-failed:
+				return waitForChunks()
+			}
+			continue
+		}
+
+		// The last chunk is sent once all the others are sent and the content is verified
+		if err := waitForChunks(); err != nil {
+			return err
+		}
+		if err := finishContentLibraryItemStream(reader, totalSize-position, beforeLastChunk); err != nil {
+			return err
+		}
+		if err := uploadContentLibraryItemChunkWithRetries(client, file, data, pendingChunk.Start, totalSize); err != nil {
+			return err
+		}
+		progress.add(int64(len(data)))
+		journal.remove()
+		return nil
+	}
+
+	// Nothing was pending
+	if err := waitForChunks(); err != nil {
+		return err
+	}
+	return finishContentLibraryItemStream(reader, totalSize-position, beforeLastChunk)
+}
+
+// finishContentLibraryItemStream skips the remaining bytes of a file that VCFA already received, and calls
+// 'beforeLastChunk', if set
+func finishContentLibraryItemStream(reader io.Reader, remaining int64, beforeLastChunk func() error) error {
+	if err := skipBytes(reader, remaining); err != nil {
+		return err
+	}
+	if beforeLastChunk != nil {
+		return beforeLastChunk()
+	}
+	return nil
+}
+
+// getContentLibraryItemResumeJournal returns the journal that tells which chunks of the given file VCFA already
+// received, and whether the file must be uploaded serially:
+//   - A file without transferred bytes gets an empty journal
+//   - A file with a journal left by an interrupted run resumes from it, sending only the chunks that are missing
+//   - Otherwise, the whole file is sent again serially from the beginning. The previous run may have sent the chunks
+//     in parallel and with a different parallelism, so VCFA may have received them out of order, and it does not tell
+//     which ones are missing
+func getContentLibraryItemResumeJournal(file *types.ContentLibraryItemFile, totalSize int64, journalDirectory string) (*contentLibraryItemUploadJournal, bool) {
+	transferred := min(file.BytesTransferred, totalSize)
+	if transferred <= 0 {
+		return newContentLibraryItemUploadJournal(journalDirectory, file.TransferUrl, totalSize), false
+	}
+
+	journal := loadContentLibraryItemUploadJournal(journalDirectory, file.TransferUrl, totalSize)
+	if journal != nil {
+		// VCFA may have received chunks that were not recorded yet when the run was interrupted, but never less
+		recorded := journal.completedBytes()
+		if recorded <= transferred && recorded < totalSize {
+			log.Printf("[DEBUG] resuming the upload of %s file '%s', %d bytes were received in previous runs", labelVcfaContentLibraryItem, file.Name, recorded)
+			return journal, false
+		}
+		log.Printf("[DEBUG] ignoring the upload journal of %s file '%s', as it records %d bytes but VCFA received %d", labelVcfaContentLibraryItem, file.Name, recorded, transferred)
+	}
+
+	log.Printf("[DEBUG] the received chunks of %s file '%s' are unknown, so it is uploaded again serially from the beginning", labelVcfaContentLibraryItem, file.Name)
+	return newContentLibraryItemUploadJournal(journalDirectory, file.TransferUrl, totalSize), true
+}
+
+// skipBytes discards the given amount of bytes from the reader, seeking when possible
+func skipBytes(reader io.Reader, n int64) error {
+	if n <= 0 {
+		return nil
+	}
+	if seeker, ok := reader.(io.Seeker); ok {
+		_, err := seeker.Seek(n, io.SeekCurrent)
+		return err
+	}
+	_, err := io.CopyN(io.Discard, reader, n)
+	return err
+}
+
+// uploadContentLibraryItemChunkWithRetries sends a chunk, sending it again with an exponential backoff when it fails
+// because of network errors or transient server errors
+func uploadContentLibraryItemChunkWithRetries(client *govcd.Client, file *types.ContentLibraryItemFile, chunk []byte, offset, totalSize int64) error {
+	backoff := contentLibraryItemChunkBackoff
+	var err error
+	for attempt := 0; attempt <= contentLibraryItemChunkRetries; attempt++ {
+		if attempt > 0 {
+			log.Printf("[DEBUG] retrying chunk %d-%d of %s file '%s' in %s (attempt %d of %d): %s",
+				offset, offset+int64(len(chunk))-1, labelVcfaContentLibraryItem, file.Name, backoff, attempt, contentLibraryItemChunkRetries, err)
+			time.Sleep(backoff)
+			backoff = min(backoff*2, contentLibraryItemChunkMaxBackoff)
+		}
+		err = uploadContentLibraryItemChunk(client, file.TransferUrl, chunk, offset, totalSize)
+		if err == nil {
+			return nil
+		}
+		var chunkErr *contentLibraryItemChunkError
+		if errors.As(err, &chunkErr) && !chunkErr.retryable() {
+			break
+		}
+	}
+	return &contentLibraryItemTransferError{
+		err: fmt.Errorf("error uploading %s file '%s': %s", labelVcfaContentLibraryItem, file.Name, err),
+	}
+}
+
+// uploadContentLibraryItemChunk sends a single chunk of a file, starting at the given offset, to the transfer URL
+func uploadContentLibraryItemChunk(client *govcd.Client, transferUrl string, chunk []byte, offset, totalSize int64) error {
+	parsedUrl, err := url.ParseRequestURI(transferUrl)
+	if err != nil {
+		return &contentLibraryItemChunkError{message: fmt.Sprintf("error parsing transfer URL '%s': %s", transferUrl, err)}
+	}
+
+	req := client.NewRequestWitNotEncodedParams(nil, nil, http.MethodPut, *parsedUrl, bytes.NewReader(chunk))
+	req.ContentLength = int64(len(chunk))
+	req.Header.Set("Content-Length", strconv.FormatInt(req.ContentLength, 10))
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+int64(len(chunk))-1, totalSize))
+
+	resp, err := client.Http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Printf("[DEBUG] could not close upload response body: %s", err)
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return &contentLibraryItemChunkError{
+			statusCode: resp.StatusCode,
+			message:    fmt.Sprintf("got HTTP status %s: %s", resp.Status, strings.TrimSpace(string(body))),
+		}
+	}
+	return nil
+}
+
+// transferProgress logs the progress of a file upload or download at a regular interval
+type transferProgress struct {
+	transferred atomic.Int64
+	done        chan struct{}
+}
+
+// newTransferProgress starts logging the transfer progress of the given file every 'interval'. The 'action' is the
+// verb used in the logs, like "Uploaded" or "Downloaded"
+func newTransferProgress(action, name string, transferred, total int64, interval time.Duration) *transferProgress {
+	p := &transferProgress{done: make(chan struct{})}
+	p.transferred.Store(transferred)
+	if interval <= 0 {
+		return p
+	}
+	start, startTransferred := time.Now(), transferred
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+				current := p.transferred.Load()
+				rate := float64(current-startTransferred) / time.Since(start).Seconds() / (1024 * 1024)
+				log.Printf("[DEBUG] %s %s file '%s': %d/%d bytes (%.1f%%, %.2f MB/s)",
+					action, labelVcfaContentLibraryItem, name, current, total, float64(current)*100/float64(total), rate)
+			}
+		}
+	}()
+	return p
+}
+
+func (p *transferProgress) add(n int64) {
+	p.transferred.Add(n)
+}
+
+// Write allows using the progress as an io.Writer, counting the written bytes as transferred
+func (p *transferProgress) Write(b []byte) (int, error) {
+	p.add(int64(len(b)))
+	return len(b), nil
+}
+
+func (p *transferProgress) stop() {
+	close(p.done)
+}
+
+// getContentLibraryItemPendingFiles polls the files of the Content Library Item until VCFA requests at least the
+// given amount of them
+func getContentLibraryItemPendingFiles(tmClient *VCDClient, cli *types.ContentLibraryItem, expectedAtLeast int) ([]*types.ContentLibraryItemFile, error) {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + fmt.Sprintf(types.OpenApiEndpointContentLibraryItemFiles, cli.ID))
+	if err != nil {
+		return nil, fmt.Errorf("error building endpoint to retrieve the files of %s '%s': %s", labelVcfaContentLibraryItem, cli.Name, err)
+	}
+
+	for i := 0; i < contentLibraryItemFilesPollingRetries; i++ {
+		var files []*types.ContentLibraryItemFile
+		err = client.OpenApiGetAllItems(client.APIVersion, urlRef, nil, &files, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving the files of %s '%s': %s", labelVcfaContentLibraryItem, cli.Name, err)
+		}
+		if len(files) >= expectedAtLeast {
+			return files, nil
+		}
+		time.Sleep(contentLibraryItemFilesPollingDelay)
+	}
+	return nil, fmt.Errorf("was expecting at least %d files to upload for %s '%s' in %d retries, but failed",
+		expectedAtLeast, labelVcfaContentLibraryItem, cli.Name, contentLibraryItemFilesPollingRetries)
+}
+
+// errNoContentLibraryItemUploadTask is returned when a Content Library Item does not have any upload task in progress
+var errNoContentLibraryItemUploadTask = errors.New("no upload task in progress")
+
+// waitForContentLibraryItemUploadTask waits for the upload task of the given Content Library Item, if there is any.
+// If the Content Library Item is quarantined, it returns without waiting, as the task is blocked until it is approved
+func waitForContentLibraryItemUploadTask(tmClient *VCDClient, cli *types.ContentLibraryItem) error {
+	task, err := getContentLibraryItemUploadTask(tmClient, cli)
+	if errors.Is(err, errNoContentLibraryItemUploadTask) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for {
+		err = task.Refresh()
+		if err != nil {
+			return err
+		}
+		switch task.Task.Status {
+		case "success":
+			return nil
+		case "error", "aborted", "canceled":
+			// Returns the error of the task
+			return task.WaitTaskCompletion()
+		}
+
+		current, err := tmClient.GetContentLibraryItemById(cli.ID)
+		if err != nil {
+			return err
+		}
+		if isContentLibraryItemQuarantined(current.ContentLibraryItem.Status) {
+			log.Printf("[DEBUG] %s '%s' is quarantined, its upload finishes once it is approved", labelVcfaContentLibraryItem, cli.Name)
+			return nil
+		}
+		time.Sleep(contentLibraryItemFilesPollingDelay)
+	}
+}
+
+// cancelContentLibraryItemUploadTask cancels the upload task of the given Content Library Item
+func cancelContentLibraryItemUploadTask(tmClient *VCDClient, cli *types.ContentLibraryItem) error {
+	task, err := getContentLibraryItemUploadTask(tmClient, cli)
+	if err != nil {
+		return err
+	}
+	return task.CancelTask()
+}
+
+// getContentLibraryItemUploadTask retrieves the upload task in progress of the given Content Library Item
+func getContentLibraryItemUploadTask(tmClient *VCDClient, cli *types.ContentLibraryItem) (*govcd.Task, error) {
+	client := &tmClient.VCDClient.Client
+	taskRecords, err := client.QueryTaskList(map[string]string{
+		"name":       "contentLibraryItemUpload",
+		"status":     "running,preRunning,postRunning,queued",
+		"objectType": "contentLibraryItem",
+		"objectName": cli.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the upload task of %s '%s': %s", labelVcfaContentLibraryItem, cli.Name, err)
+	}
+	// The task references the item by HREF, which ends with the UUID of its ID
+	itemUuid := cli.ID[strings.LastIndex(cli.ID, ":")+1:]
+	for _, tr := range taskRecords {
+		if !strings.HasSuffix(tr.Object, itemUuid) {
+			continue
+		}
+		task, err := client.GetTaskByHREF(tr.HREF)
+		if govcd.ContainsNotFound(err) {
+			// The task finished in the meantime
+			return nil, errNoContentLibraryItemUploadTask
+		}
+		return task, err
+	}
+	return nil, errNoContentLibraryItemUploadTask
+}
@@ -0,0 +1,146 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// contentLibraryItemByteRange is a range of bytes of a file, from Start (included) to End (excluded)
+type contentLibraryItemByteRange struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// contentLibraryItemUploadJournal records the byte ranges of a file that VCFA already received. Chunks are uploaded in
+// parallel and can finish out of order, so the amount of bytes transferred reported by VCFA does not tell which parts
+// of the file are missing when an interrupted upload is resumed.
+// The journal is stored in the directory set in 'upload_journal_directory', identified by the transfer URL of the file,
+// and it is removed once the file is completely uploaded.
+type contentLibraryItemUploadJournal struct {
+	path      string
+	mutex     sync.Mutex
+	TotalSize int64                         `json:"totalSize"`
+	Completed []contentLibraryItemByteRange `json:"completed"`
+}
+
+// getContentLibraryItemUploadJournalPath returns the path of the journal of the file with the given transfer URL, inside
+// the given directory
+func getContentLibraryItemUploadJournalPath(directory, transferUrl string) string {
+	hash := sha256.Sum256([]byte(transferUrl))
+	return filepath.Join(directory, "vcfa-content-library-item-upload-"+hex.EncodeToString(hash[:16])+".json")
+}
+
+// newContentLibraryItemUploadJournal returns an empty journal for a file of the given size, replacing any journal
+// left by a previous upload with the same transfer URL
+func newContentLibraryItemUploadJournal(directory, transferUrl string, totalSize int64) *contentLibraryItemUploadJournal {
+	return &contentLibraryItemUploadJournal{
+		path:      getContentLibraryItemUploadJournalPath(directory, transferUrl),
+		TotalSize: totalSize,
+	}
+}
+
+// loadContentLibraryItemUploadJournal reads the journal of the file with the given transfer URL. It returns nil if
+// there is no journal, or if it does not describe a file of the given size.
+func loadContentLibraryItemUploadJournal(directory, transferUrl string, totalSize int64) *contentLibraryItemUploadJournal {
+	journal := newContentLibraryItemUploadJournal(directory, transferUrl, totalSize)
+	content, err := os.ReadFile(journal.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] could not read the upload journal '%s': %s", journal.path, err)
+		}
+		return nil
+	}
+	if err = json.Unmarshal(content, journal); err != nil {
+		log.Printf("[DEBUG] ignoring invalid upload journal '%s': %s", journal.path, err)
+		return nil
+	}
+	if journal.TotalSize != totalSize {
+		log.Printf("[DEBUG] ignoring upload journal '%s', as it belongs to a file of %d bytes instead of %d", journal.path, journal.TotalSize, totalSize)
+		return nil
+	}
+	return journal
+}
+
+// completedBytes returns the amount of bytes recorded as received by VCFA
+func (j *contentLibraryItemUploadJournal) completedBytes() int64 {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	var total int64
+	for _, r := range j.Completed {
+		total += r.End - r.Start
+	}
+	return total
+}
+
+// add records the given range as received by VCFA, and saves the journal. Failing to save the journal is not an
+// error, as it only prevents resuming the upload without sending the whole file again.
+func (j *contentLibraryItemUploadJournal) add(start, end int64) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.Completed = mergeContentLibraryItemByteRanges(append(j.Completed, contentLibraryItemByteRange{Start: start, End: end}))
+
+	content, err := json.Marshal(j)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(j.path), 0700)
+	}
+	if err == nil {
+		// The journal is replaced atomically, so an interruption never leaves it half written
+		tmpPath := j.path + ".tmp"
+		err = os.WriteFile(tmpPath, content, 0600)
+		if err == nil {
+			err = os.Rename(tmpPath, j.path)
+		}
+	}
+	if err != nil {
+		log.Printf("[DEBUG] could not save the upload journal '%s': %s", j.path, err)
+	}
+}
+
+// remove deletes the journal, once the file is completely uploaded
+func (j *contentLibraryItemUploadJournal) remove() {
+	if err := os.Remove(j.path); err != nil && !os.IsNotExist(err) {
+		log.Printf("[DEBUG] could not remove the upload journal '%s': %s", j.path, err)
+	}
+}
+
+// pendingChunks splits the ranges that are not recorded in the journal into chunks of, at most, 'pieceSize' bytes
+func (j *contentLibraryItemUploadJournal) pendingChunks(pieceSize int64) []contentLibraryItemByteRange {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	var chunks []contentLibraryItemByteRange
+	offset := int64(0)
+	for _, completed := range append(j.Completed, contentLibraryItemByteRange{Start: j.TotalSize, End: j.TotalSize}) {
+		for ; offset < completed.Start; offset += pieceSize {
+			chunks = append(chunks, contentLibraryItemByteRange{Start: offset, End: min(offset+pieceSize, completed.Start)})
+		}
+		offset = completed.End
+	}
+	return chunks
+}
+
+// mergeContentLibraryItemByteRanges sorts the given ranges and merges the ones that overlap or are contiguous
+func mergeContentLibraryItemByteRanges(ranges []contentLibraryItemByteRange) []contentLibraryItemByteRange {
+	sort.Slice(ranges, func(i, k int) bool {
+		return ranges[i].Start < ranges[k].Start
+	})
+	merged := make([]contentLibraryItemByteRange, 0, len(ranges))
+	for _, r := range ranges {
+		last := len(merged) - 1
+		if last >= 0 && r.Start <= merged[last].End {
+			merged[last].End = max(merged[last].End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
@@ -0,0 +1,387 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const testUploadPieceSize = 16
+
+// fakeTransferServer simulates the transfer URL of a Content Library Item file, which receives the file chunks with
+// a 'Content-Range' header
+type fakeTransferServer struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	content  []byte
+	received int64           // Amount of bytes received successfully, counting the ones received more than once
+	offsets  []int64         // Offsets of the chunks received successfully, in order of arrival
+	attempts map[int64]int   // Attempts to send the chunk that starts at every offset
+	failures map[int64][]int // HTTP status codes returned to the first attempts of the chunk that starts at every offset
+}
+
+func newFakeTransferServer(t *testing.T, totalSize int) *fakeTransferServer {
+	s := &fakeTransferServer{
+		content:  make([]byte, totalSize),
+		attempts: map[int64]int{},
+		failures: map[int64][]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var start, end, total int64
+		if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil || int64(len(body)) != end-start+1 || total != int64(len(s.content)) {
+			http.Error(w, "invalid chunk", http.StatusBadRequest)
+			return
+		}
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.attempts[start]++
+		if failures := s.failures[start]; len(failures) > 0 {
+			s.failures[start] = failures[1:]
+			http.Error(w, "simulated failure", failures[0])
+			return
+		}
+		copy(s.content[start:], body)
+		s.received += int64(len(body))
+		s.offsets = append(s.offsets, start)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// file returns the Content Library Item file that VCFA would report after receiving the given amount of bytes
+func (s *fakeTransferServer) file(bytesTransferred int64) *types.ContentLibraryItemFile {
+	return &types.ContentLibraryItemFile{
+		Name:              "disk.vmdk",
+		TransferUrl:       s.URL + "/transfer/disk.vmdk",
+		ExpectedSizeBytes: int64(len(s.content)),
+		BytesTransferred:  bytesTransferred,
+	}
+}
+
+// nonSeekableReader hides the io.Seeker implementation of a reader, like the body of an HTTP response
+type nonSeekableReader struct {
+	io.Reader
+}
+
+// setupUploadUnitTest shortens the backoff between retries, and returns the upload options with the given parallelism
+// and a journal directory that belongs only to the test
+func setupUploadUnitTest(t *testing.T, parallelism int) contentLibraryItemUploadOptions {
+	backoff, maxBackoff := contentLibraryItemChunkBackoff, contentLibraryItemChunkMaxBackoff
+	contentLibraryItemChunkBackoff, contentLibraryItemChunkMaxBackoff = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		contentLibraryItemChunkBackoff, contentLibraryItemChunkMaxBackoff = backoff, maxBackoff
+	})
+	return contentLibraryItemUploadOptions{
+		pieceSize:        testUploadPieceSize,
+		parallelism:      parallelism,
+		journalDirectory: filepath.Join(t.TempDir(), "journals"),
+	}
+}
+
+// testUploadContent returns the content of a file with the given amount of chunks, where every byte is different
+// from the byte in the same position of the other chunks
+func testUploadContent(chunks int) []byte {
+	content := make([]byte, chunks*testUploadPieceSize)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	return content
+}
+
+func TestUploadContentLibraryItemStreamRetries(t *testing.T) {
+	opts := setupUploadUnitTest(t, 3)
+	content := testUploadContent(6)
+	server := newFakeTransferServer(t, len(content))
+	server.failures[testUploadPieceSize] = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	server.failures[5*testUploadPieceSize] = []int{http.StatusBadGateway}
+
+	err := uploadContentLibraryItemStream(&govcd.Client{}, server.file(0), bytes.NewReader(content), int64(len(content)), opts, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(server.content, content) {
+		t.Errorf("the uploaded content is different from the source")
+	}
+	if server.received != int64(len(content)) {
+		t.Errorf("expected %d bytes to be received, got %d", len(content), server.received)
+	}
+	if server.attempts[testUploadPieceSize] != 3 || server.attempts[5*testUploadPieceSize] != 2 {
+		t.Errorf("expected the failed chunks to be retried, got attempts %v", server.attempts)
+	}
+	if server.offsets[len(server.offsets)-1] != 5*testUploadPieceSize {
+		t.Errorf("expected the last chunk to be received the last one, got offsets %v", server.offsets)
+	}
+	if _, err := os.Stat(getContentLibraryItemUploadJournalPath(opts.journalDirectory, server.file(0).TransferUrl)); !os.IsNotExist(err) {
+		t.Errorf("expected the upload journal to be removed after the upload, got: %v", err)
+	}
+}
+
+func TestUploadContentLibraryItemStreamResumesMissingChunks(t *testing.T) {
+	opts := setupUploadUnitTest(t, 4)
+	content := testUploadContent(8)
+	server := newFakeTransferServer(t, len(content))
+	// A non retryable error interrupts the upload of the second chunk, while other chunks are sent in parallel
+	server.failures[testUploadPieceSize] = []int{http.StatusBadRequest}
+
+	err := uploadContentLibraryItemStream(&govcd.Client{}, server.file(0), bytes.NewReader(content), int64(len(content)), opts, nil)
+	var transferErr *contentLibraryItemTransferError
+	if !errors.As(err, &transferErr) {
+		t.Fatalf("expected a transfer error, got: %v", err)
+	}
+	if server.attempts[testUploadPieceSize] != 1 {
+		t.Errorf("expected the chunk to not be retried after a non retryable error, got %d attempts", server.attempts[testUploadPieceSize])
+	}
+	if server.attempts[7*testUploadPieceSize] != 0 {
+		t.Fatalf("expected the last chunk to not be sent after an error")
+	}
+
+	// The next run resumes the upload, sending only the chunks that were not received
+	interrupted := server.received
+	err = uploadContentLibraryItemStream(&govcd.Client{}, server.file(interrupted), nonSeekableReader{bytes.NewReader(content)}, int64(len(content)), opts, nil)
+	if err != nil {
+		t.Fatalf("unexpected error resuming the upload: %s", err)
+	}
+	if !bytes.Equal(server.content, content) {
+		t.Errorf("the uploaded content is different from the source")
+	}
+	if server.received != int64(len(content)) {
+		t.Errorf("expected every byte to be received once (%d bytes), got %d", len(content), server.received)
+	}
+}
+
+func TestUploadContentLibraryItemStreamResumesWithoutJournal(t *testing.T) {
+	type testCase struct {
+		name        string
+		parallelism int
+	}
+	// The received chunks are unknown without a journal, whatever the parallelism, so the whole file is sent
+	// again serially from the beginning
+	testCases := []testCase{
+		{name: "Serial", parallelism: 1},
+		{name: "Parallel", parallelism: 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := setupUploadUnitTest(t, tc.parallelism)
+			content := testUploadContent(4)
+			server := newFakeTransferServer(t, len(content))
+			copy(server.content, content[:2*testUploadPieceSize])
+
+			lastChunkSent := false
+			beforeLastChunk := func() error {
+				server.mutex.Lock()
+				defer server.mutex.Unlock()
+				lastChunkSent = server.attempts[3*testUploadPieceSize] > 0
+				return nil
+			}
+			err := uploadContentLibraryItemStream(&govcd.Client{}, server.file(2*testUploadPieceSize), nonSeekableReader{bytes.NewReader(content)}, int64(len(content)), opts, beforeLastChunk)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !bytes.Equal(server.content, content) {
+				t.Errorf("the uploaded content is different from the source")
+			}
+			expectedOffsets := []int64{0, testUploadPieceSize, 2 * testUploadPieceSize, 3 * testUploadPieceSize}
+			if !reflect.DeepEqual(server.offsets, expectedOffsets) {
+				t.Errorf("expected chunks %v to be sent in order, got %v", expectedOffsets, server.offsets)
+			}
+			if lastChunkSent {
+				t.Errorf("expected the last chunk to be sent after calling 'beforeLastChunk'")
+			}
+		})
+	}
+}
+
+func TestGetContentLibraryItemResumeJournal(t *testing.T) {
+	const totalSize = 4 * testUploadPieceSize
+	type testCase struct {
+		name              string
+		transferred       int64
+		recorded          []contentLibraryItemByteRange
+		expectedCompleted int64
+		expectedSerial    bool
+	}
+	testCases := []testCase{
+		{name: "NothingTransferred"},
+		{name: "NothingTransferredIgnoresJournal", recorded: []contentLibraryItemByteRange{{0, testUploadPieceSize}}},
+		{name: "BytesTransferredWithoutJournal", transferred: 2 * testUploadPieceSize, expectedSerial: true},
+		{
+			name:              "BytesTransferredWithJournal",
+			transferred:       2 * testUploadPieceSize,
+			recorded:          []contentLibraryItemByteRange{{testUploadPieceSize, 2 * testUploadPieceSize}},
+			expectedCompleted: testUploadPieceSize,
+		},
+		{
+			name:           "JournalRecordsMoreThanTransferred",
+			transferred:    testUploadPieceSize,
+			recorded:       []contentLibraryItemByteRange{{0, 2 * testUploadPieceSize}},
+			expectedSerial: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			directory := filepath.Join(t.TempDir(), "journals")
+			file := &types.ContentLibraryItemFile{Name: "disk.vmdk", TransferUrl: "https://example.com/transfer/disk.vmdk", BytesTransferred: tc.transferred}
+			if len(tc.recorded) > 0 {
+				previous := newContentLibraryItemUploadJournal(directory, file.TransferUrl, totalSize)
+				for _, r := range tc.recorded {
+					previous.add(r.Start, r.End)
+				}
+			}
+
+			journal, serial := getContentLibraryItemResumeJournal(file, totalSize, directory)
+			if serial != tc.expectedSerial {
+				t.Errorf("expected serial upload: %t, got %t", tc.expectedSerial, serial)
+			}
+			if completed := journal.completedBytes(); completed != tc.expectedCompleted {
+				t.Errorf("expected %d bytes to be skipped, got %d", tc.expectedCompleted, completed)
+			}
+		})
+	}
+}
+
+func TestUploadContentLibraryItemStreamBeforeLastChunkError(t *testing.T) {
+	opts := setupUploadUnitTest(t, 2)
+	content := testUploadContent(3)
+	server := newFakeTransferServer(t, len(content))
+
+	err := uploadContentLibraryItemStream(&govcd.Client{}, server.file(0), bytes.NewReader(content), int64(len(content)), opts, func() error {
+		return fmt.Errorf("checksum mismatch")
+	})
+	if err == nil || err.Error() != "checksum mismatch" {
+		t.Fatalf("expected the error of 'beforeLastChunk', got: %v", err)
+	}
+	sort.Slice(server.offsets, func(i, k int) bool { return server.offsets[i] < server.offsets[k] })
+	if !reflect.DeepEqual(server.offsets, []int64{0, testUploadPieceSize}) {
+		t.Errorf("expected every chunk but the last one to be sent, got %v", server.offsets)
+	}
+}
+
+func TestContentLibraryItemUploadJournalPendingChunks(t *testing.T) {
+	type testCase struct {
+		name      string
+		completed []contentLibraryItemByteRange
+		expected  []contentLibraryItemByteRange
+	}
+	testCases := []testCase{
+		{
+			name:     "Empty",
+			expected: []contentLibraryItemByteRange{{0, 6}, {6, 12}, {12, 18}, {18, 20}},
+		},
+		{
+			name:      "Gaps",
+			completed: []contentLibraryItemByteRange{{0, 4}, {8, 9}, {12, 18}},
+			expected:  []contentLibraryItemByteRange{{4, 8}, {9, 12}, {18, 20}},
+		},
+		{
+			name:      "LastChunkCompleted",
+			completed: []contentLibraryItemByteRange{{6, 20}},
+			expected:  []contentLibraryItemByteRange{{0, 6}},
+		},
+		{
+			name:      "AllCompleted",
+			completed: []contentLibraryItemByteRange{{0, 20}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			journal := &contentLibraryItemUploadJournal{TotalSize: 20, Completed: tc.completed}
+			got := journal.pendingChunks(6)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected pending chunks %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMergeContentLibraryItemByteRanges(t *testing.T) {
+	got := mergeContentLibraryItemByteRanges([]contentLibraryItemByteRange{{32, 48}, {0, 16}, {64, 80}, {16, 32}, {40, 50}})
+	expected := []contentLibraryItemByteRange{{0, 50}, {64, 80}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected merged ranges %v, got %v", expected, got)
+	}
+}
+
+func TestContentLibraryItemFingerprints(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "test.iso")
+	if err := os.WriteFile(filePath, []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	missingPath := filepath.Join(dir, "missing.iso")
+
+	fingerprints, missing, err := contentLibraryItemFingerprints([]string{filePath, missingPath})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(missing, []string{missingPath}) {
+		t.Errorf("expected missing files %v, got %v", []string{missingPath}, missing)
+	}
+	if len(fingerprints) != 1 || fingerprints["test.iso"] == "" {
+		t.Fatalf("expected the fingerprint of 'test.iso', got %v", fingerprints)
+	}
+	stored := map[string]interface{}{"test.iso": fingerprints["test.iso"]}
+	if !fingerprintsEqual(stored, fingerprints) {
+		t.Errorf("expected fingerprints %v to be equal to the stored ones %v", fingerprints, stored)
+	}
+
+	// A different modification time changes the fingerprint, even if the content is the same
+	if err := os.Chtimes(filePath, time.Now(), time.Now().Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	fingerprints, _, err = contentLibraryItemFingerprints([]string{filePath})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fingerprintsEqual(stored, fingerprints) {
+		t.Errorf("expected the fingerprint to change after modifying the file")
+	}
+}
+
+func TestValidateContentLibraryItemMissingFiles(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "test.iso")
+	if err := os.WriteFile(filePath, []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	rawConfig := cty.ObjectVal(map[string]cty.Value{
+		"file_paths": cty.SetVal([]cty.Value{cty.StringVal(filePath), cty.StringVal(filePath + ".missing")}),
+	})
+
+	resp := &schema.ValidateResourceConfigFuncResponse{}
+	validateContentLibraryItemMissingFiles(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: rawConfig}, resp)
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics.HasError() {
+		t.Fatalf("expected a warning about the missing file, got: %v", resp.Diagnostics)
+	}
+	if !strings.Contains(resp.Diagnostics[0].Detail, filePath+".missing") {
+		t.Errorf("expected the warning to mention the missing file, got: %s", resp.Diagnostics[0].Detail)
+	}
+}
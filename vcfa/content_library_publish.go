@@ -0,0 +1,90 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// contentLibrarySubscribersEndpoint is used to retrieve the Content Libraries that are subscribed to a published one
+const contentLibrarySubscribersEndpoint = "contentLibraries/%s/subscribers"
+
+// tmContentLibraryPublishConfig represents the publishing settings of a Content Library
+type tmContentLibraryPublishConfig struct {
+	// Whether this Content Library is published, so other Content Libraries can subscribe to it
+	IsPublished bool `json:"isPublished"`
+	// Whether the subscribers must authenticate with a password to access the published content
+	PasswordProtected bool `json:"passwordProtected"`
+	// Password that subscribers must use to authenticate. It is never returned by the backend
+	Password string `json:"password,omitempty"`
+	// The URL that subscribers must use to subscribe to this Content Library. This is a ReadOnly field
+	PublishUrl string `json:"publishUrl,omitempty"`
+}
+
+// tmContentLibrarySubscriber represents a Content Library that is subscribed to a published Content Library
+type tmContentLibrarySubscriber struct {
+	// The reference to the subscribed Content Library
+	ContentLibrary types.OpenApiReference `json:"contentLibrary"`
+	// The reference to the organization that the subscribed Content Library belongs to
+	Org *types.OpenApiReference `json:"org,omitempty"`
+}
+
+// getContentLibrarySubscribers retrieves the Content Libraries that are subscribed to the given published Content Library
+func getContentLibrarySubscribers(tmClient *VCDClient, cl *govcd.ContentLibrary) ([]map[string]interface{}, error) {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + fmt.Sprintf(contentLibrarySubscribersEndpoint, cl.ContentLibrary.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	var subscribers []*tmContentLibrarySubscriber
+	err = client.OpenApiGetAllItems(client.APIVersion, urlRef, nil, &subscribers, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving subscribers of %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+
+	result := make([]map[string]interface{}, len(subscribers))
+	for i, subscriber := range subscribers {
+		result[i] = map[string]interface{}{
+			"content_library_id":   subscriber.ContentLibrary.ID,
+			"content_library_name": subscriber.ContentLibrary.Name,
+		}
+		if subscriber.Org != nil {
+			result[i]["org_id"] = subscriber.Org.ID
+			result[i]["org_name"] = subscriber.Org.Name
+		}
+	}
+	return result, nil
+}
+
+// contentLibrarySubscriberSchema defines a Content Library that is subscribed to a published Content Library
+var contentLibrarySubscriberSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"content_library_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the subscribed %s", labelVcfaContentLibrary),
+		},
+		"content_library_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the subscribed %s", labelVcfaContentLibrary),
+		},
+		"org_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s that the subscribed %s belongs to", labelVcfaOrg, labelVcfaContentLibrary),
+		},
+		"org_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s that the subscribed %s belongs to", labelVcfaOrg, labelVcfaContentLibrary),
+		},
+	},
+}
@@ -0,0 +1,213 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const (
+	// contentLibrarySyncEndpoint is used to trigger a synchronization of a subscribed Content Library with its publisher
+	contentLibrarySyncEndpoint = "contentLibraries/%s/sync"
+
+	contentLibrarySyncModeAutomatic = "AUTOMATIC"
+	contentLibrarySyncModeOnDemand  = "ON_DEMAND"
+)
+
+// tmContentLibrarySubscriptionConfig extends types.ContentLibrarySubscriptionConfig with the synchronization
+// settings of a subscribed Content Library
+type tmContentLibrarySubscriptionConfig struct {
+	// Subscription url of this Content Library. It cannot be changed once set for a Content Library
+	SubscriptionUrl string `json:"subscriptionUrl"`
+	// Whether to eagerly download content from publisher and store it locally. When false, the content
+	// of the items is only downloaded when it is used
+	NeedLocalCopy bool `json:"needLocalCopy"`
+	// Password to use to authenticate with the publisher
+	Password string `json:"password,omitempty"`
+	// Whether the Content Library is synchronized automatically with its publisher. When false, the
+	// Content Library is only synchronized on demand
+	AutomaticSyncEnabled bool `json:"automaticSyncEnabled"`
+}
+
+// tmContentLibrary is the OpenAPI payload of a Content Library, with the extended subscription and publishing settings
+type tmContentLibrary struct {
+	types.ContentLibrary
+	// SubscriptionConfig shadows the one from types.ContentLibrary
+	SubscriptionConfig *tmContentLibrarySubscriptionConfig `json:"subscriptionConfig,omitempty"`
+	// An object representing publishing settings of a Content Library
+	PublishConfig *tmContentLibraryPublishConfig `json:"publishConfig,omitempty"`
+}
+
+// getContentLibraryTenantHeaders returns the headers required to manage a Content Library of the given Organization
+// as a tenant. For Provider Content Libraries, no headers are returned
+func getContentLibraryTenantHeaders(org *types.OpenApiReference) map[string]string {
+	if org == nil || org.ID == "" || org.Name == "" || strings.EqualFold(org.Name, "system") {
+		return nil
+	}
+	return map[string]string{
+		types.HeaderTenantContext: org.ID[strings.LastIndex(org.ID, ":")+1:],
+		types.HeaderAuthContext:   org.Name,
+	}
+}
+
+// createTmContentLibrary creates a Content Library with the extended subscription and publishing settings, and returns its ID
+func createTmContentLibrary(tmClient *VCDClient, config *tmContentLibrary, tenantContext *govcd.TenantContext) (string, error) {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + types.OpenApiEndpointContentLibraries)
+	if err != nil {
+		return "", err
+	}
+
+	var org *types.OpenApiReference
+	if tenantContext != nil {
+		org = &types.OpenApiReference{ID: tenantContext.OrgId, Name: tenantContext.OrgName}
+	}
+	created := &tmContentLibrary{}
+	err = client.OpenApiPostItem(client.APIVersion, urlRef, nil, config, created, getContentLibraryTenantHeaders(org))
+	if err != nil {
+		return "", fmt.Errorf("error creating %s: %s", labelVcfaContentLibrary, err)
+	}
+	return created.ID, nil
+}
+
+// updateTmContentLibrary updates the given Content Library with the extended subscription and publishing settings
+func updateTmContentLibrary(tmClient *VCDClient, cl *govcd.ContentLibrary, config *tmContentLibrary) error {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf+types.OpenApiEndpointContentLibraries, cl.ContentLibrary.ID)
+	if err != nil {
+		return err
+	}
+
+	config.ID = cl.ContentLibrary.ID
+	err = client.OpenApiPutItem(client.APIVersion, urlRef, nil, config, &tmContentLibrary{}, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+	if err != nil {
+		return fmt.Errorf("error updating %s: %s", labelVcfaContentLibrary, err)
+	}
+	return nil
+}
+
+// getTmContentLibrary retrieves the given Content Library with its extended subscription and publishing settings
+func getTmContentLibrary(tmClient *VCDClient, cl *govcd.ContentLibrary) (*tmContentLibrary, error) {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf+types.OpenApiEndpointContentLibraries, cl.ContentLibrary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &tmContentLibrary{}
+	err = client.OpenApiGetItem(client.APIVersion, urlRef, nil, result, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving settings of %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+	return result, nil
+}
+
+// syncContentLibrary triggers a synchronization of the given subscribed Content Library with its publisher,
+// and waits for it to finish
+func syncContentLibrary(tmClient *VCDClient, cl *govcd.ContentLibrary) error {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + fmt.Sprintf(contentLibrarySyncEndpoint, cl.ContentLibrary.ID))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] synchronizing %s '%s' with its publisher", labelVcfaContentLibrary, cl.ContentLibrary.Name)
+	task, err := client.OpenApiPostItemAsyncWithHeaders(client.APIVersion, urlRef, nil, nil, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+	if err != nil {
+		return fmt.Errorf("error synchronizing %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+	err = task.WaitTaskCompletion()
+	if err != nil {
+		return fmt.Errorf("error waiting for the synchronization of %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+	return nil
+}
+
+// waitForContentLibrarySync waits until the given subscribed Content Library is synchronized with its publisher, this is,
+// until its status is no longer NOT_READY
+func waitForContentLibrarySync(ctx context.Context, tmClient *VCDClient, id string, tenantContext *govcd.TenantContext, timeout time.Duration) error {
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"NOT_READY"},
+		Target:  []string{"READY", "PARTIALLY_READY"},
+		Refresh: func() (any, string, error) {
+			cl, err := tmClient.GetContentLibraryById(id, tenantContext)
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] %s %s current status is %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, cl.ContentLibrary.Status)
+			if strings.ToUpper(cl.ContentLibrary.Status) == "FAILED" {
+				return nil, "", fmt.Errorf("%s %s synchronization FAILED", labelVcfaContentLibrary, cl.ContentLibrary.Name)
+			}
+			return cl, strings.ToUpper(cl.ContentLibrary.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s to be synchronized: %s", labelVcfaContentLibrary, id, err)
+	}
+	return nil
+}
+
+// getContentLibraryItemSyncStatus returns the synchronization status of all the items of the given Content Library
+func getContentLibraryItemSyncStatus(cl *govcd.ContentLibrary) ([]map[string]interface{}, error) {
+	items, err := cl.GetAllContentLibraryItems(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %ss of %s '%s': %s", labelVcfaContentLibraryItem, labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+	result := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		result[i] = map[string]interface{}{
+			"id":                   item.ContentLibraryItem.ID,
+			"name":                 item.ContentLibraryItem.Name,
+			"status":               item.ContentLibraryItem.Status,
+			"version":              item.ContentLibraryItem.Version,
+			"last_successful_sync": item.ContentLibraryItem.LastSuccessfulSync,
+		}
+	}
+	return result, nil
+}
+
+// contentLibraryItemSyncStatusSchema defines the computed synchronization status of an item of a subscribed Content Library
+var contentLibraryItemSyncStatusSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelVcfaContentLibraryItem),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelVcfaContentLibraryItem),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Status of the %s", labelVcfaContentLibraryItem),
+		},
+		"version": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Version of the %s, which is the same as in the publisher", labelVcfaContentLibraryItem),
+		},
+		"last_successful_sync": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The ISO-8601 timestamp representing when the %s was last synchronized", labelVcfaContentLibraryItem),
+		},
+	},
+}
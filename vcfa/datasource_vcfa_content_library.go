@@ -79,6 +79,47 @@ func datasourceVcfaContentLibrary() *schema.Resource {
 							Computed:    true,
 							Description: fmt.Sprintf("Subscription url of this %s", labelVcfaContentLibrary),
 						},
+						"sync_mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("How this %s is synchronized with the publisher, either 'AUTOMATIC' or 'ON_DEMAND'", labelVcfaContentLibrary),
+						},
+						"download_content_lazily": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: fmt.Sprintf("Whether the content of the %ss is only downloaded from the publisher when it is used", labelVcfaContentLibraryItem),
+						},
+					},
+				},
+			},
+			"item_sync_status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("Synchronization status of the %ss of this %s, when it is subscribed", labelVcfaContentLibraryItem, labelVcfaContentLibrary),
+				Elem:        contentLibraryItemSyncStatusSchema,
+			},
+			"publish_config": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("A block representing publishing settings of a %s", labelVcfaContentLibrary),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: fmt.Sprintf("Whether this %s is published", labelVcfaContentLibrary),
+						},
+						"publish_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The URL that subscribers must use to subscribe to this %s", labelVcfaContentLibrary),
+						},
+						"subscribers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: fmt.Sprintf("The %ss that are subscribed to this %s", labelVcfaContentLibrary, labelVcfaContentLibrary),
+							Elem:        contentLibrarySubscriberSchema,
+						},
 					},
 				},
 			},
@@ -126,6 +167,7 @@ func datasourceVcfaContentLibrary() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Status of this %s. Can be 'READY', 'NOT_READY', 'FAILED' or 'PARTIALLY_READY'", labelVcfaContentLibrary),
 			},
+			"metadata": openApiMetadataSchema(true),
 		},
 	}
 }
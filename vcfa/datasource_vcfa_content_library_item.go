@@ -7,9 +7,13 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
 )
 
 func datasourceVcfaContentLibraryItem() *schema.Resource {
@@ -71,11 +75,18 @@ func datasourceVcfaContentLibraryItem() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Status of this %s", labelVcfaContentLibraryItem),
 			},
+			"quarantine_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Quarantine state of this %s. Empty if it is not affected by quarantine", labelVcfaContentLibraryItem),
+			},
 			"version": {
 				Type:        schema.TypeInt,
 				Computed:    true,
 				Description: fmt.Sprintf("The version of this %s. For a subscribed library, this version is same as in publisher library", labelVcfaContentLibraryItem),
 			},
+			"metadata":        openApiMetadataSchema(true),
+			"metadata_filter": openApiMetadataFilterSchema,
 		},
 	}
 }
@@ -88,7 +99,13 @@ func datasourceVcfaContentLibraryItemRead(_ context.Context, d *schema.ResourceD
 		return diag.Errorf("error retrieving %s: %s", labelVcfaContentLibrary, err)
 	}
 
-	cli, err := cl.GetContentLibraryItemByName(d.Get("name").(string))
+	var cli *govcd.ContentLibraryItem
+	if d.Get("metadata_filter").(*schema.Set).Len() > 0 {
+		metadataFilters, unfilteredMetadata := getOpenApiMetadataFilters(d)
+		cli, err = getContentLibraryItemByNameAndMetadata(tmClient, cl, d.Get("name").(string), metadataFilters, unfilteredMetadata)
+	} else {
+		cli, err = cl.GetContentLibraryItemByName(d.Get("name").(string))
+	}
 	if err != nil {
 		return diag.Errorf("error retrieving %s: %s", labelVcfaContentLibraryItem, err)
 	}
@@ -100,3 +117,54 @@ func datasourceVcfaContentLibraryItemRead(_ context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// getContentLibraryItemByNameAndMetadata retrieves the Content Library Item with the given name that has all the given
+// metadata, which are encoded FIQL filters plus the filters that VCFA cannot apply, see getOpenApiMetadataFilters
+func getContentLibraryItemByNameAndMetadata(tmClient *VCDClient, cl *govcd.ContentLibrary, name string, metadataFilters []string, unfilteredMetadata []openApiMetadataFilter) (*govcd.ContentLibraryItem, error) {
+	filters := append([]string{"contentLibrary.id==" + cl.ContentLibrary.ID}, metadataFilters...)
+	if !strings.ContainsAny(name, fiqlUnsupportedCharacters) {
+		filters = append(filters, "name=="+url.QueryEscape(name))
+	}
+	queryParams := url.Values{}
+	queryParams.Add("filter", strings.Join(filters, ";"))
+	queryParams.Add("filterEncoded", "true")
+
+	allItems, err := cl.GetAllContentLibraryItems(queryParams)
+	if err != nil {
+		return nil, err
+	}
+	var items []*govcd.ContentLibraryItem
+	for _, item := range allItems {
+		// The name is not part of the filter when VCFA cannot filter it
+		if item.ContentLibraryItem.Name != name {
+			continue
+		}
+		matches, err := contentLibraryItemMetadataMatches(tmClient, item, unfilteredMetadata)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%s '%s' with the given metadata: %s", labelVcfaContentLibraryItem, name, govcd.ErrorEntityNotFound)
+	}
+	if len(items) > 1 {
+		return nil, fmt.Errorf("found %d %ss with name '%s' and the given metadata", len(items), labelVcfaContentLibraryItem, name)
+	}
+	return items[0], nil
+}
+
+// contentLibraryItemMetadataMatches returns true if the given Content Library Item has all the metadata of the given
+// filters. The metadata is only retrieved when there are filters to check
+func contentLibraryItemMetadataMatches(tmClient *VCDClient, cli *govcd.ContentLibraryItem, filters []openApiMetadataFilter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+	entries, err := getOpenApiMetadata(tmClient, types.OpenApiEndpointContentLibraryItems, cli.ContentLibraryItem.ID, getContentLibraryTenantHeaders(cli.ContentLibraryItem.Org))
+	if err != nil {
+		return false, err
+	}
+	return openApiMetadataMatches(entries, filters), nil
+}
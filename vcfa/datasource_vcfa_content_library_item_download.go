@@ -0,0 +1,107 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func datasourceVcfaContentLibraryItemDownload() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaContentLibraryItemDownloadRead,
+		Schema: map[string]*schema.Schema{
+			"content_library_item_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s to download", labelVcfaContentLibraryItem),
+			},
+			"download_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Local directory where the files of the %s are downloaded. It is created if it does not exist", labelVcfaContentLibraryItem),
+			},
+			"download_progress_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How often, in seconds, the download progress of every file is logged. 0 disables the progress logs",
+			},
+			"file_paths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: fmt.Sprintf("Local paths of the downloaded files, which can be used in 'file_paths' of a %s. "+
+					"For OVFs, the descriptor is the first one", labelVcfaContentLibraryItem),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"file_checksums": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "SHA-256 checksums of the downloaded files, keyed by file name",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"downloaded_files": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the files that were downloaded during this read",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"skipped_files": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the files that were not downloaded, as they were already present with the same contents",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func datasourceVcfaContentLibraryItemDownloadRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	cli, err := tmClient.GetContentLibraryItemById(d.Get("content_library_item_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaContentLibraryItem, err)
+	}
+
+	progressInterval := time.Duration(d.Get("download_progress_interval_seconds").(int)) * time.Second
+	result, err := downloadContentLibraryItem(tmClient, cli, d.Get("download_path").(string), progressInterval)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("file_paths", result.filePaths)
+	if err != nil {
+		return diag.Errorf("error storing 'file_paths': %s", err)
+	}
+	err = d.Set("file_checksums", result.checksums)
+	if err != nil {
+		return diag.Errorf("error storing 'file_checksums': %s", err)
+	}
+	err = d.Set("downloaded_files", result.downloaded)
+	if err != nil {
+		return diag.Errorf("error storing 'downloaded_files': %s", err)
+	}
+	err = d.Set("skipped_files", result.skipped)
+	if err != nil {
+		return diag.Errorf("error storing 'skipped_files': %s", err)
+	}
+	d.SetId(cli.ContentLibraryItem.ID)
+	return nil
+}
@@ -0,0 +1,248 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+func datasourceVcfaContentLibraryItems() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaContentLibraryItemsRead,
+		Schema: map[string]*schema.Schema{
+			"content_library_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s to search %ss in", labelVcfaContentLibrary, labelVcfaContentLibraryItem),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description: fmt.Sprintf("Regular expression that the names of the %ss must match. When it is anchored with '^', "+
+					"its literal prefix is also used to filter the %ss in VCFA", labelVcfaContentLibraryItem, labelVcfaContentLibraryItem),
+			},
+			"item_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ISO", "TEMPLATE"}, false),
+				Description:  fmt.Sprintf("Type of the %ss to retrieve, either 'ISO' or 'TEMPLATE' (OVA/OVF)", labelVcfaContentLibraryItem),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(contentLibraryItemStatuses, false),
+				Description: fmt.Sprintf("Status of the %ss to retrieve, one of '%s'", labelVcfaContentLibraryItem,
+					strings.Join(contentLibraryItemStatuses, "', '")),
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  fmt.Sprintf("Only retrieve %ss created at or after this RFC3339 timestamp", labelVcfaContentLibraryItem),
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  fmt.Sprintf("Only retrieve %ss created at or before this RFC3339 timestamp", labelVcfaContentLibraryItem),
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: fmt.Sprintf("If true, only the most recently created %s that matches the filters is retrieved, and it is an error if none matches", labelVcfaContentLibraryItem),
+			},
+			"metadata_filter": openApiMetadataFilterSchema,
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("%ss that match the filters, from the most recent to the oldest", labelVcfaContentLibraryItem),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("ID of the %s", labelVcfaContentLibraryItem),
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("Name of the %s", labelVcfaContentLibraryItem),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The description of the %s", labelVcfaContentLibraryItem),
+						},
+						"item_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The type of %s", labelVcfaContentLibraryItem),
+						},
+						"image_identifier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("Virtual Machine Identifier (VMI) of the %s", labelVcfaContentLibraryItem),
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("Status of the %s", labelVcfaContentLibraryItem),
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: fmt.Sprintf("The version of the %s", labelVcfaContentLibraryItem),
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The ISO-8601 timestamp representing when the %s was created", labelVcfaContentLibraryItem),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceVcfaContentLibraryItemsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	cl, err := tmClient.GetContentLibraryById(d.Get("content_library_id").(string), nil)
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaContentLibrary, err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return diag.Errorf("name_regex: %s", err)
+		}
+	}
+
+	metadataFilters, unfilteredMetadata := getOpenApiMetadataFilters(d)
+	queryParams := getContentLibraryItemsQueryParams(d, cl, metadataFilters)
+	log.Printf("[DEBUG] retrieving %ss with query '%s'", labelVcfaContentLibraryItem, queryParams.Encode())
+	allItems, err := cl.GetAllContentLibraryItems(queryParams)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss: %s", labelVcfaContentLibraryItem, err)
+	}
+
+	items := make([]map[string]interface{}, 0)
+	for _, item := range allItems {
+		// The name filter sent to VCFA is a prefix at most, so the full regular expression is always checked
+		if nameRegex != nil && !nameRegex.MatchString(item.ContentLibraryItem.Name) {
+			continue
+		}
+		matches, err := contentLibraryItemMetadataMatches(tmClient, item, unfilteredMetadata)
+		if err != nil {
+			return diag.Errorf("error retrieving metadata of %s '%s': %s", labelVcfaContentLibraryItem, item.ContentLibraryItem.Name, err)
+		}
+		if !matches {
+			continue
+		}
+		items = append(items, map[string]interface{}{
+			"id":               item.ContentLibraryItem.ID,
+			"name":             item.ContentLibraryItem.Name,
+			"description":      item.ContentLibraryItem.Description,
+			"item_type":        item.ContentLibraryItem.ItemType,
+			"image_identifier": item.ContentLibraryItem.ImageIdentifier,
+			"status":           item.ContentLibraryItem.Status,
+			"version":          item.ContentLibraryItem.Version,
+			"creation_date":    item.ContentLibraryItem.CreationDate,
+		})
+		if d.Get("most_recent").(bool) {
+			// Items are sorted by creation date, so the first match is the most recent one
+			break
+		}
+	}
+	if d.Get("most_recent").(bool) && len(items) == 0 {
+		return diag.Errorf("no %s in %s '%s' matches the filters", labelVcfaContentLibraryItem, labelVcfaContentLibrary, cl.ContentLibrary.Name)
+	}
+
+	err = d.Set("items", items)
+	if err != nil {
+		return diag.Errorf("error storing 'items': %s", err)
+	}
+	d.SetId(cl.ContentLibrary.ID)
+	return nil
+}
+
+// getContentLibraryItemsQueryParams builds the FIQL filter and sorting to retrieve the Content Library Items that
+// match the data source arguments and the given metadata filters. Values are encoded like go-vcloud-director does
+func getContentLibraryItemsQueryParams(d *schema.ResourceData, cl *govcd.ContentLibrary, metadataFilters []string) url.Values {
+	filters := []string{"contentLibrary.id==" + cl.ContentLibrary.ID}
+	if v, ok := d.GetOk("name_regex"); ok {
+		if prefix := getRegexLiteralPrefix(v.(string)); prefix != "" {
+			filters = append(filters, fmt.Sprintf("name==%s*", url.QueryEscape(prefix)))
+		}
+	}
+	if v, ok := d.GetOk("item_type"); ok {
+		filters = append(filters, "itemType=="+url.QueryEscape(v.(string)))
+	}
+	if v, ok := d.GetOk("status"); ok {
+		filters = append(filters, "status=="+url.QueryEscape(v.(string)))
+	}
+	if v, ok := d.GetOk("created_after"); ok {
+		filters = append(filters, "creationDate=ge="+getFiqlTimestamp(v.(string)))
+	}
+	if v, ok := d.GetOk("created_before"); ok {
+		filters = append(filters, "creationDate=le="+getFiqlTimestamp(v.(string)))
+	}
+	filters = append(filters, metadataFilters...)
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", strings.Join(filters, ";"))
+	queryParams.Add("filterEncoded", "true")
+	queryParams.Add("sortDesc", "creationDate")
+	return queryParams
+}
+
+// getFiqlTimestamp converts the given RFC3339 timestamp to UTC, so its time zone does not add a plus sign to the
+// FIQL filter, which VCFA cannot filter
+func getFiqlTimestamp(timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		// Timestamps are validated in the schema
+		return url.QueryEscape(timestamp)
+	}
+	return url.QueryEscape(t.UTC().Format(time.RFC3339Nano))
+}
+
+// getRegexLiteralPrefix returns the literal text that any string must start with to match the given regular expression,
+// as long as it is anchored with '^' and the text can be used safely in a FIQL filter. Otherwise, returns an empty string
+func getRegexLiteralPrefix(expr string) string {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	re = re.Simplify()
+	if re.Op != syntax.OpConcat || len(re.Sub) < 2 || re.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+	literal := re.Sub[1]
+	if literal.Op != syntax.OpLiteral || literal.Flags&syntax.FoldCase != 0 {
+		return ""
+	}
+	prefix := string(literal.Rune)
+	if strings.ContainsAny(prefix, fiqlUnsupportedCharacters+`()=!<>'"`) {
+		return ""
+	}
+	return prefix
+}
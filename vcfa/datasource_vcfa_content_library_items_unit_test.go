@@ -0,0 +1,51 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import "testing"
+
+func TestGetRegexLiteralPrefix(t *testing.T) {
+	type testCase struct {
+		name     string
+		expr     string
+		expected string
+	}
+
+	testCases := []testCase{
+		{name: "AnchoredLiteral", expr: "^ubuntu", expected: "ubuntu"},
+		{name: "AnchoredLiteralWithSuffix", expr: "^ubuntu-.*-amd64$", expected: "ubuntu-"},
+		{name: "TextAnchor", expr: `\Aubuntu`, expected: "ubuntu"},
+		{name: "NotAnchored", expr: "ubuntu"},
+		{name: "OnlyEndAnchor", expr: "ubuntu$"},
+		{name: "MultiLineAnchor", expr: "(?m)^ubuntu"},
+		{name: "OnlyAnchors", expr: "^$"},
+		{name: "CaseInsensitive", expr: "(?i)^ubuntu"},
+		{name: "EscapedDot", expr: `^ubuntu\.22`, expected: "ubuntu.22"},
+		{name: "UnescapedDot", expr: "^ubuntu.22", expected: "ubuntu"},
+		{name: "EscapedStar", expr: `^ubuntu\*`},
+		{name: "EscapedComma", expr: `^ubuntu\,22`},
+		{name: "Space", expr: "^ubuntu 22"},
+		{name: "EscapedPlus", expr: `^ubuntu\+22`},
+		{name: "EscapedSemicolon", expr: `^ubuntu\;22`},
+		{name: "EscapedParenthesis", expr: `^ubuntu\(22\)`},
+		{name: "Alternation", expr: "^(a|b)"},
+		{name: "AlternationOfWords", expr: "^(ubuntu|debian)"},
+		{name: "AlternationAfterLiteral", expr: "^os-(ubuntu|debian)", expected: "os-"},
+		{name: "OptionalLastCharacter", expr: "^ubuntus?", expected: "ubuntu"},
+		{name: "OptionalFirstCharacter", expr: "^u?buntu"},
+		{name: "OptionalGroup", expr: "^ubuntu(-22)?", expected: "ubuntu"},
+		{name: "InvalidExpression", expr: "^ubuntu("},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := getRegexLiteralPrefix(tc.expr); got != tc.expected {
+				t.Errorf("expected prefix %q for %q, got %q", tc.expected, tc.expr, got)
+			}
+		})
+	}
+}
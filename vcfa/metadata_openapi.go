@@ -0,0 +1,418 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const (
+	metadataVisibilityReadWrite = "READWRITE"
+	metadataVisibilityReadOnly  = "READONLY"
+	metadataVisibilityPrivate   = "PRIVATE"
+)
+
+// fiqlUnsupportedCharacters are the characters that VCFA rejects in FIQL filters, even when they are encoded. Like
+// go-vcloud-director does, values that contain them are not filtered by VCFA, but compared once the entities are retrieved
+const fiqlUnsupportedCharacters = ",; +*"
+
+// openApiMetadataSchema defines the metadata entries of an entity that supports OpenAPI metadata
+var openApiMetadataSchema = func(isDatasource bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    !isDatasource,
+		Computed:    isDatasource,
+		Description: "Metadata entries, with typed values and visibility",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    !isDatasource,
+					Computed:    isDatasource,
+					Description: "Key of the metadata entry",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    !isDatasource,
+					Computed:    isDatasource,
+					Description: "Value of the metadata entry. It must be a number for 'NumberEntry' and 'true' or 'false' for 'BoolEntry' types",
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Optional: !isDatasource,
+					Computed: isDatasource,
+					Default: func() interface{} {
+						if isDatasource {
+							return nil
+						}
+						return types.OpenApiMetadataStringEntry
+					}(),
+					ValidateFunc: func() schema.SchemaValidateFunc {
+						if isDatasource {
+							return nil
+						}
+						return validation.StringInSlice([]string{types.OpenApiMetadataStringEntry, types.OpenApiMetadataNumberEntry, types.OpenApiMetadataBooleanEntry}, false)
+					}(),
+					Description: fmt.Sprintf("Type of the metadata entry value, one of '%s', '%s' or '%s'",
+						types.OpenApiMetadataStringEntry, types.OpenApiMetadataNumberEntry, types.OpenApiMetadataBooleanEntry),
+				},
+				"visibility": {
+					Type:     schema.TypeString,
+					Optional: !isDatasource,
+					Computed: isDatasource,
+					Default: func() interface{} {
+						if isDatasource {
+							return nil
+						}
+						return metadataVisibilityReadWrite
+					}(),
+					ValidateFunc: func() schema.SchemaValidateFunc {
+						if isDatasource {
+							return nil
+						}
+						return validation.StringInSlice([]string{metadataVisibilityReadWrite, metadataVisibilityReadOnly, metadataVisibilityPrivate}, false)
+					}(),
+					Description: fmt.Sprintf("Visibility of the metadata entry for tenants. '%s' entries can be modified by tenants, '%s' "+
+						"entries can only be read by them and '%s' entries are only visible to providers",
+						metadataVisibilityReadWrite, metadataVisibilityReadOnly, metadataVisibilityPrivate),
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Optional:    !isDatasource,
+					Computed:    isDatasource,
+					Description: "Namespace of the metadata entry, which allows having several entries with the same key",
+				},
+			},
+		},
+	}
+}
+
+// openApiMetadataFilterSchema defines metadata filters for entity lookups
+var openApiMetadataFilterSchema = &schema.Schema{
+	Type:        schema.TypeSet,
+	Optional:    true,
+	Description: "Metadata entries that the retrieved entities must have",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key of the metadata entry",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Value of the metadata entry",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      types.OpenApiMetadataStringEntry,
+				ValidateFunc: validation.StringInSlice([]string{types.OpenApiMetadataStringEntry, types.OpenApiMetadataNumberEntry, types.OpenApiMetadataBooleanEntry}, false),
+				Description: fmt.Sprintf("Type of the metadata entry value, one of '%s', '%s' or '%s'",
+					types.OpenApiMetadataStringEntry, types.OpenApiMetadataNumberEntry, types.OpenApiMetadataBooleanEntry),
+			},
+		},
+	},
+}
+
+// openApiMetadataFilter is an entry of 'metadata_filter'
+type openApiMetadataFilter struct {
+	key       string
+	value     string
+	entryType string
+}
+
+// getOpenApiMetadataFilters returns the FIQL filters that match the entries of 'metadata_filter'. Keys and values are
+// encoded like go-vcloud-director does, so the query must set 'filterEncoded'. The entries with characters that VCFA
+// cannot filter are returned separately, to be checked with openApiMetadataMatches once the entities are retrieved
+func getOpenApiMetadataFilters(d *schema.ResourceData) ([]string, []openApiMetadataFilter) {
+	var filters []string
+	var unfiltered []openApiMetadataFilter
+	for _, f := range d.Get("metadata_filter").(*schema.Set).List() {
+		filter := openApiMetadataFilter{
+			key:       f.(map[string]interface{})["key"].(string),
+			value:     f.(map[string]interface{})["value"].(string),
+			entryType: f.(map[string]interface{})["type"].(string),
+		}
+		if strings.ContainsAny(filter.key, fiqlUnsupportedCharacters) || strings.ContainsAny(filter.value, fiqlUnsupportedCharacters) {
+			unfiltered = append(unfiltered, filter)
+			continue
+		}
+		var queryType string
+		switch filter.entryType {
+		case types.OpenApiMetadataNumberEntry:
+			queryType = "NUMBER"
+		case types.OpenApiMetadataBooleanEntry:
+			queryType = "BOOLEAN"
+		default:
+			queryType = "STRING"
+		}
+		filters = append(filters, fmt.Sprintf("metadata:%s==%s:%s", url.QueryEscape(filter.key), queryType, url.QueryEscape(filter.value)))
+	}
+	return filters, unfiltered
+}
+
+// openApiMetadataMatches returns true if the given metadata entries match all the given filters
+func openApiMetadataMatches(entries []*types.OpenApiMetadataEntry, filters []openApiMetadataFilter) bool {
+	for _, filter := range filters {
+		value, err := getCanonicalOpenApiMetadataValue(filter.entryType, filter.value)
+		if err != nil {
+			return false
+		}
+		found := false
+		for _, entry := range entries {
+			if entry.KeyValue.Key == filter.key && entry.KeyValue.Value.Type == filter.entryType && getOpenApiMetadataEntryForState(entry)["value"] == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// openApiMetadataEntryId identifies a metadata entry in an entity, which is unique per namespace and key
+func openApiMetadataEntryId(namespace, key string) string {
+	return namespace + "/" + key
+}
+
+// parseOpenApiMetadataValue converts the value of a 'metadata' block into the typed value of an OpenAPI metadata entry
+func parseOpenApiMetadataValue(entryType, rawValue string) (interface{}, error) {
+	switch entryType {
+	case types.OpenApiMetadataNumberEntry:
+		return strconv.ParseFloat(rawValue, 64)
+	case types.OpenApiMetadataBooleanEntry:
+		return strconv.ParseBool(rawValue)
+	default:
+		return rawValue, nil
+	}
+}
+
+// formatOpenApiMetadataValue converts the typed value of an OpenAPI metadata entry into the value of a 'metadata' block
+func formatOpenApiMetadataValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// getCanonicalOpenApiMetadataValue returns the given value of a 'metadata' block as it is read back from VCFA, like '1'
+// for the number '1.0' or 'true' for the boolean 'True'
+func getCanonicalOpenApiMetadataValue(entryType, rawValue string) (string, error) {
+	value, err := parseOpenApiMetadataValue(entryType, rawValue)
+	if err != nil {
+		return "", err
+	}
+	return formatOpenApiMetadataValue(value), nil
+}
+
+// validateOpenApiMetadataDiff checks that the values of the 'metadata' blocks are valid for their type, and that they
+// are written as VCFA returns them, as otherwise they would be planned to be updated on every run
+func validateOpenApiMetadataDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	metadata := d.GetRawConfig().GetAttr("metadata")
+	if !metadata.IsKnown() || metadata.IsNull() {
+		return nil
+	}
+	for it := metadata.ElementIterator(); it.Next(); {
+		_, entry := it.Element()
+		key, value, entryType := entry.GetAttr("key"), entry.GetAttr("value"), entry.GetAttr("type")
+		if !key.IsKnown() || key.IsNull() || !value.IsKnown() || value.IsNull() || !entryType.IsKnown() {
+			continue
+		}
+		typeName := types.OpenApiMetadataStringEntry
+		if !entryType.IsNull() {
+			typeName = entryType.AsString()
+		}
+		canonical, err := getCanonicalOpenApiMetadataValue(typeName, value.AsString())
+		if err != nil {
+			return fmt.Errorf("metadata: value '%s' of entry '%s' is not a valid %s", value.AsString(), key.AsString(), typeName)
+		}
+		if canonical != value.AsString() {
+			return fmt.Errorf("metadata: value '%s' of entry '%s' must be written as '%s', which is how VCFA returns it", value.AsString(), key.AsString(), canonical)
+		}
+	}
+	return nil
+}
+
+// getOpenApiMetadataEntryFromConfig converts a 'metadata' block into an OpenAPI metadata entry
+func getOpenApiMetadataEntryFromConfig(entry map[string]interface{}) (*types.OpenApiMetadataEntry, error) {
+	key := entry["key"].(string)
+	rawValue := entry["value"].(string)
+
+	value, err := parseOpenApiMetadataValue(entry["type"].(string), rawValue)
+	if err != nil {
+		return nil, fmt.Errorf("value '%s' of metadata entry '%s' is not a valid %s: %s", rawValue, key, entry["type"].(string), err)
+	}
+
+	result := &types.OpenApiMetadataEntry{
+		KeyValue: types.OpenApiMetadataKeyValue{
+			Domain:    "TENANT",
+			Key:       key,
+			Namespace: entry["namespace"].(string),
+			Value: types.OpenApiMetadataTypedValue{
+				Type:  entry["type"].(string),
+				Value: value,
+			},
+		},
+	}
+	switch entry["visibility"].(string) {
+	case metadataVisibilityReadOnly:
+		result.IsReadOnly = true
+	case metadataVisibilityPrivate:
+		result.KeyValue.Domain = "PROVIDER"
+	}
+	return result, nil
+}
+
+// getOpenApiMetadataEntryForState converts an OpenAPI metadata entry into a 'metadata' block
+func getOpenApiMetadataEntryForState(entry *types.OpenApiMetadataEntry) map[string]interface{} {
+	visibility := metadataVisibilityReadWrite
+	if strings.EqualFold(entry.KeyValue.Domain, "PROVIDER") {
+		visibility = metadataVisibilityPrivate
+	} else if entry.IsReadOnly {
+		visibility = metadataVisibilityReadOnly
+	}
+	return map[string]interface{}{
+		"key":        entry.KeyValue.Key,
+		"value":      formatOpenApiMetadataValue(entry.KeyValue.Value.Value),
+		"type":       entry.KeyValue.Value.Type,
+		"visibility": visibility,
+		"namespace":  entry.KeyValue.Namespace,
+	}
+}
+
+// getOpenApiMetadataEndpoint returns the URL of the metadata of the given entity. The endpoint is the
+// one of the entity, like types.OpenApiEndpointContentLibraries
+func getOpenApiMetadataEndpoint(tmClient *VCDClient, endpoint, objectId string, entryId string) (*url.URL, error) {
+	path := fmt.Sprintf("%s%s%s/metadata", types.OpenApiPathVcf, endpoint, objectId)
+	if entryId != "" {
+		path += "/" + entryId
+	}
+	return tmClient.VCDClient.Client.OpenApiBuildEndpoint(path)
+}
+
+// getOpenApiMetadata retrieves all the metadata entries of the given entity
+// TODO: TM: go-vcloud-director only exposes its OpenAPI metadata helpers (govcd.OpenApiMetadataEntry) for Defined
+// Entities, and they do not send the tenant headers that Content Libraries require. This file uses the same
+// types.OpenApiMetadataEntry payloads, and should move to those helpers once they are available for these entities
+func getOpenApiMetadata(tmClient *VCDClient, endpoint, objectId string, headers map[string]string) ([]*types.OpenApiMetadataEntry, error) {
+	client := &tmClient.VCDClient.Client
+	urlRef, err := getOpenApiMetadataEndpoint(tmClient, endpoint, objectId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*types.OpenApiMetadataEntry
+	err = client.OpenApiGetAllItems(client.APIVersion, urlRef, nil, &entries, headers)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving metadata of '%s': %s", objectId, err)
+	}
+	return entries, nil
+}
+
+// setOpenApiMetadataInState saves all the metadata entries of the given entity in the 'metadata' attribute
+func setOpenApiMetadataInState(tmClient *VCDClient, d *schema.ResourceData, endpoint, objectId string, headers map[string]string) error {
+	entries, err := getOpenApiMetadata(tmClient, endpoint, objectId, headers)
+	if err != nil {
+		return err
+	}
+	metadata := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		metadata[i] = getOpenApiMetadataEntryForState(entry)
+	}
+	return d.Set("metadata", metadata)
+}
+
+// updateOpenApiMetadata makes the metadata entries of the given entity match the 'metadata' argument. Entries
+// that are not in the argument are removed
+func updateOpenApiMetadata(tmClient *VCDClient, d *schema.ResourceData, endpoint, objectId string, headers map[string]string) error {
+	client := &tmClient.VCDClient.Client
+
+	existing, err := getOpenApiMetadata(tmClient, endpoint, objectId, headers)
+	if err != nil {
+		return err
+	}
+	existingById := make(map[string]*types.OpenApiMetadataEntry, len(existing))
+	for _, entry := range existing {
+		existingById[openApiMetadataEntryId(entry.KeyValue.Namespace, entry.KeyValue.Key)] = entry
+	}
+
+	wanted := make(map[string]*types.OpenApiMetadataEntry)
+	for _, rawEntry := range d.Get("metadata").(*schema.Set).List() {
+		entry, err := getOpenApiMetadataEntryFromConfig(rawEntry.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		id := openApiMetadataEntryId(entry.KeyValue.Namespace, entry.KeyValue.Key)
+		if _, ok := wanted[id]; ok {
+			return fmt.Errorf("metadata entry with key '%s' and namespace '%s' is defined more than once", entry.KeyValue.Key, entry.KeyValue.Namespace)
+		}
+		wanted[id] = entry
+	}
+
+	for id, entry := range existingById {
+		want, ok := wanted[id]
+		// Only the value of an entry can be updated, changing the type or visibility requires re-creating it
+		if ok && want.KeyValue.Value.Type == entry.KeyValue.Value.Type && want.KeyValue.Domain == entry.KeyValue.Domain && want.IsReadOnly == entry.IsReadOnly {
+			delete(wanted, id)
+			if formatOpenApiMetadataValue(want.KeyValue.Value.Value) == formatOpenApiMetadataValue(entry.KeyValue.Value.Value) {
+				continue
+			}
+			log.Printf("[DEBUG] updating metadata entry '%s' of '%s'", id, objectId)
+			urlRef, err := getOpenApiMetadataEndpoint(tmClient, endpoint, objectId, entry.ID)
+			if err != nil {
+				return err
+			}
+			entry.KeyValue.Value.Value = want.KeyValue.Value.Value
+			err = client.OpenApiPutItem(client.APIVersion, urlRef, nil, entry, nil, headers)
+			if err != nil {
+				return fmt.Errorf("error updating metadata entry '%s' of '%s': %s", entry.KeyValue.Key, objectId, err)
+			}
+			continue
+		}
+
+		log.Printf("[DEBUG] deleting metadata entry '%s' of '%s'", id, objectId)
+		urlRef, err := getOpenApiMetadataEndpoint(tmClient, endpoint, objectId, entry.ID)
+		if err != nil {
+			return err
+		}
+		err = client.OpenApiDeleteItem(client.APIVersion, urlRef, nil, headers)
+		if err != nil {
+			return fmt.Errorf("error deleting metadata entry '%s' of '%s': %s", entry.KeyValue.Key, objectId, err)
+		}
+	}
+
+	if len(wanted) == 0 {
+		return nil
+	}
+	urlRef, err := getOpenApiMetadataEndpoint(tmClient, endpoint, objectId, "")
+	if err != nil {
+		return err
+	}
+	for id, entry := range wanted {
+		log.Printf("[DEBUG] adding metadata entry '%s' to '%s'", id, objectId)
+		err = client.OpenApiPostItem(client.APIVersion, urlRef, nil, entry, nil, headers)
+		if err != nil {
+			return fmt.Errorf("error adding metadata entry '%s' to '%s': %s", entry.KeyValue.Key, objectId, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func TestGetCanonicalOpenApiMetadataValue(t *testing.T) {
+	type testCase struct {
+		name          string
+		entryType     string
+		value         string
+		expected      string
+		expectedError bool
+	}
+
+	testCases := []testCase{
+		{name: "String", entryType: types.OpenApiMetadataStringEntry, value: " 1.0 ", expected: " 1.0 "},
+		{name: "Integer", entryType: types.OpenApiMetadataNumberEntry, value: "1", expected: "1"},
+		{name: "IntegerWithDecimals", entryType: types.OpenApiMetadataNumberEntry, value: "1.0", expected: "1"},
+		{name: "Decimal", entryType: types.OpenApiMetadataNumberEntry, value: "1.50", expected: "1.5"},
+		{name: "Exponent", entryType: types.OpenApiMetadataNumberEntry, value: "1e3", expected: "1000"},
+		{name: "InvalidNumber", entryType: types.OpenApiMetadataNumberEntry, value: "one", expectedError: true},
+		{name: "Boolean", entryType: types.OpenApiMetadataBooleanEntry, value: "true", expected: "true"},
+		{name: "CapitalizedBoolean", entryType: types.OpenApiMetadataBooleanEntry, value: "True", expected: "true"},
+		{name: "NumericBoolean", entryType: types.OpenApiMetadataBooleanEntry, value: "0", expected: "false"},
+		{name: "InvalidBoolean", entryType: types.OpenApiMetadataBooleanEntry, value: "yes", expectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := getCanonicalOpenApiMetadataValue(tc.entryType, tc.value)
+			if tc.expectedError {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestOpenApiMetadataEntryRoundTrip(t *testing.T) {
+	for _, entryType := range []string{types.OpenApiMetadataStringEntry, types.OpenApiMetadataNumberEntry, types.OpenApiMetadataBooleanEntry} {
+		config := map[string]interface{}{
+			"key":        "key",
+			"value":      map[string]string{types.OpenApiMetadataStringEntry: "value", types.OpenApiMetadataNumberEntry: "1.5", types.OpenApiMetadataBooleanEntry: "false"}[entryType],
+			"type":       entryType,
+			"visibility": metadataVisibilityReadOnly,
+			"namespace":  "namespace",
+		}
+		entry, err := getOpenApiMetadataEntryFromConfig(config)
+		if err != nil {
+			t.Fatalf("unexpected error converting a %s: %s", entryType, err)
+		}
+		if got := getOpenApiMetadataEntryForState(entry); !reflect.DeepEqual(got, config) {
+			t.Errorf("expected %v to be read back, got %v", config, got)
+		}
+	}
+}
+
+func TestGetOpenApiMetadataFilters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"metadata_filter": openApiMetadataFilterSchema}, map[string]interface{}{
+		"metadata_filter": []interface{}{
+			map[string]interface{}{"key": "os", "value": "ubuntu"},
+			map[string]interface{}{"key": "owner", "value": "team/a&b=c"},
+			map[string]interface{}{"key": "size", "value": "10", "type": types.OpenApiMetadataNumberEntry},
+			map[string]interface{}{"key": "description", "value": "My image"},
+			map[string]interface{}{"key": "tags", "value": "a,b"},
+		},
+	})
+
+	filters, unfiltered := getOpenApiMetadataFilters(d)
+	sort.Strings(filters)
+	expected := []string{
+		"metadata:os==STRING:ubuntu",
+		"metadata:owner==STRING:" + url.QueryEscape("team/a&b=c"),
+		"metadata:size==NUMBER:10",
+	}
+	if !reflect.DeepEqual(filters, expected) {
+		t.Errorf("expected filters %v, got %v", expected, filters)
+	}
+	sort.Slice(unfiltered, func(i, j int) bool { return unfiltered[i].key < unfiltered[j].key })
+	expectedUnfiltered := []openApiMetadataFilter{
+		{key: "description", value: "My image", entryType: types.OpenApiMetadataStringEntry},
+		{key: "tags", value: "a,b", entryType: types.OpenApiMetadataStringEntry},
+	}
+	if !reflect.DeepEqual(unfiltered, expectedUnfiltered) {
+		t.Errorf("expected unfiltered entries %v, got %v", expectedUnfiltered, unfiltered)
+	}
+}
+
+func TestOpenApiMetadataMatches(t *testing.T) {
+	newEntry := func(key, entryType string, value interface{}) *types.OpenApiMetadataEntry {
+		return &types.OpenApiMetadataEntry{KeyValue: types.OpenApiMetadataKeyValue{Key: key, Value: types.OpenApiMetadataTypedValue{Type: entryType, Value: value}}}
+	}
+	entries := []*types.OpenApiMetadataEntry{
+		newEntry("description", types.OpenApiMetadataStringEntry, "My image"),
+		newEntry("size", types.OpenApiMetadataNumberEntry, float64(10)),
+	}
+
+	type testCase struct {
+		name     string
+		filters  []openApiMetadataFilter
+		expected bool
+	}
+
+	testCases := []testCase{
+		{name: "NoFilters", expected: true},
+		{name: "String", filters: []openApiMetadataFilter{{key: "description", value: "My image", entryType: types.OpenApiMetadataStringEntry}}, expected: true},
+		{name: "NonCanonicalNumber", filters: []openApiMetadataFilter{{key: "size", value: "10.0", entryType: types.OpenApiMetadataNumberEntry}}, expected: true},
+		{name: "AllFilters", filters: []openApiMetadataFilter{
+			{key: "description", value: "My image", entryType: types.OpenApiMetadataStringEntry},
+			{key: "size", value: "10", entryType: types.OpenApiMetadataNumberEntry},
+		}, expected: true},
+		{name: "DifferentValue", filters: []openApiMetadataFilter{{key: "description", value: "My other image", entryType: types.OpenApiMetadataStringEntry}}},
+		{name: "DifferentType", filters: []openApiMetadataFilter{{key: "size", value: "10", entryType: types.OpenApiMetadataStringEntry}}},
+		{name: "MissingKey", filters: []openApiMetadataFilter{{key: "os", value: "My image", entryType: types.OpenApiMetadataStringEntry}}},
+		{name: "OneFilterDoesNotMatch", filters: []openApiMetadataFilter{
+			{key: "description", value: "My image", entryType: types.OpenApiMetadataStringEntry},
+			{key: "size", value: "11", entryType: types.OpenApiMetadataNumberEntry},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := openApiMetadataMatches(entries, tc.filters); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -72,6 +72,8 @@ var globalDataSourceMap = map[string]*schema.Resource{
 	"vcfa_supervisor_namespace":            datasourceVcfaSupervisorNamespace(),         // 1.0
 	"vcfa_shared_subnet":                   datasourceVcfaSharedSubnet(),                // 1.1
 	"vcfa_distributed_vlan_connection":     datasourceVcfaDistributedVlanConnection(),   // 1.1
+	"vcfa_content_library_items":           datasourceVcfaContentLibraryItems(),         // 1.3
+	"vcfa_content_library_item_download":   datasourceVcfaContentLibraryItemDownload(),  // 1.3
 }
 
 var globalResourceMap = map[string]*schema.Resource{
@@ -101,6 +103,9 @@ var globalResourceMap = map[string]*schema.Resource{
 	"vcfa_supervisor_namespace":            resourceVcfaSupervisorNamespace(),         // 1.0
 	"vcfa_shared_subnet":                   resourceVcfaSharedSubnet(),                // 1.1
 	"vcfa_distributed_vlan_connection":     resourceVcfaDistributedVlanConnection(),   // 1.1
+	"vcfa_vpc":                             resourceVcfaVpc(),                         // 1.3
+	"vcfa_vpc_subnet":                      resourceVcfaVpcSubnet(),                   // 1.3
+	"vcfa_content_library_item_approval":   resourceVcfaContentLibraryItemApproval(),  // 1.3
 }
 
 // Provider returns a terraform.ResourceProvider.
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
@@ -28,7 +30,11 @@ func resourceVcfaContentLibrary() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceVcfaContentLibraryImport,
 		},
-		CustomizeDiff: resourceVcfaContentLibraryCustomizeDiff,
+		CustomizeDiff: customdiff.Sequence(resourceVcfaContentLibraryCustomizeDiff, validateOpenApiMetadataDiff),
+		Timeouts: &schema.ResourceTimeout{
+			// Only used when waiting for the first synchronization of subscribed Content Libraries, see 'sync_on_create_wait'
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -117,6 +123,71 @@ func resourceVcfaContentLibrary() *schema.Resource {
 							Sensitive:   true,
 							Description: "Password to use to authenticate with the publisher",
 						},
+						"sync_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      contentLibrarySyncModeAutomatic,
+							ValidateFunc: validation.StringInSlice([]string{contentLibrarySyncModeAutomatic, contentLibrarySyncModeOnDemand}, false),
+							Description: fmt.Sprintf("How this %s is synchronized with the publisher, either '%s' or '%s'",
+								labelVcfaContentLibrary, contentLibrarySyncModeAutomatic, contentLibrarySyncModeOnDemand),
+						},
+						"download_content_lazily": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+							Description: fmt.Sprintf("Whether the content of the %ss is only downloaded from the publisher when it is used. "+
+								"If false, all the content is downloaded eagerly and stored locally", labelVcfaContentLibraryItem),
+						},
+						"sync_on_create_wait": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: fmt.Sprintf("Whether to wait for the first synchronization of this %s to finish during creation", labelVcfaContentLibrary),
+						},
+						"sync_trigger": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: fmt.Sprintf("An arbitrary value that, when changed, triggers a synchronization of this %s with the publisher", labelVcfaContentLibrary),
+						},
+					},
+				},
+			},
+			"item_sync_status": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("Synchronization status of the %ss of this %s, when it is subscribed", labelVcfaContentLibraryItem, labelVcfaContentLibrary),
+				Elem:        contentLibraryItemSyncStatusSchema,
+			},
+			"publish_config": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"subscription_config"},
+				Description:   fmt.Sprintf("A block representing publishing settings of a %s", labelVcfaContentLibrary),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: fmt.Sprintf("Whether this %s is published, so other %ss can subscribe to it", labelVcfaContentLibrary, labelVcfaContentLibrary),
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Password that subscribers must use to authenticate. If not set, the published content is not password protected",
+						},
+						"publish_url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("The URL that subscribers must use to subscribe to this %s", labelVcfaContentLibrary),
+						},
+						"subscribers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: fmt.Sprintf("The %ss that are subscribed to this %s", labelVcfaContentLibrary, labelVcfaContentLibrary),
+							Elem:        contentLibrarySubscriberSchema,
+						},
 					},
 				},
 			},
@@ -168,6 +239,7 @@ func resourceVcfaContentLibrary() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Status of this %s. Can be 'READY', 'NOT_READY', 'FAILED' or 'PARTIALLY_READY'", labelVcfaContentLibrary),
 			},
+			"metadata": openApiMetadataSchema(false),
 		},
 	}
 }
@@ -208,13 +280,26 @@ func resourceVcfaContentLibraryCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	cl, err := tmClient.CreateContentLibrary(getContentLibraryType(d), tenantContext)
+	id, err := createTmContentLibrary(tmClient, getTmContentLibraryType(d), tenantContext)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = setContentLibraryData(tmClient, d, cl, "resource")
-	if err != nil {
-		return diag.FromErr(err)
+	d.SetId(id)
+	if d.HasChange("metadata") {
+		cl, err := tmClient.GetContentLibraryById(id, tenantContext)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = updateOpenApiMetadata(tmClient, d, types.OpenApiEndpointContentLibraries, id, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+		if err != nil {
+			return diag.Errorf("error setting metadata of %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+		}
+	}
+	if d.Get("subscription_config.0.sync_on_create_wait").(bool) {
+		err = waitForContentLibrarySync(ctx, tmClient, id, tenantContext, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	return resourceVcfaContentLibraryRead(ctx, d, meta)
 }
@@ -259,10 +344,22 @@ func resourceVcfaContentLibraryUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	_, err = cl.Update(getContentLibraryType(d))
+	err = updateTmContentLibrary(tmClient, cl, getTmContentLibraryType(d))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if d.HasChange("metadata") {
+		err = updateOpenApiMetadata(tmClient, d, types.OpenApiEndpointContentLibraries, cl.ContentLibrary.ID, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+		if err != nil {
+			return diag.Errorf("error setting metadata of %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+		}
+	}
+	if d.HasChange("subscription_config.0.sync_trigger") {
+		err = syncContentLibrary(tmClient, cl)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceVcfaContentLibraryRead(ctx, d, meta)
 }
 
@@ -354,7 +451,35 @@ func getContentLibraryType(d *schema.ResourceData) *types.ContentLibrary {
 	return t
 }
 
-func setContentLibraryData(_ *VCDClient, d *schema.ResourceData, cl *govcd.ContentLibrary, origin string) error {
+// getTmContentLibraryType returns the payload of a Content Library, which includes the synchronization settings of
+// subscribed Content Libraries and the publishing settings
+func getTmContentLibraryType(d *schema.ResourceData) *tmContentLibrary {
+	t := &tmContentLibrary{ContentLibrary: *getContentLibraryType(d)}
+	t.ContentLibrary.SubscriptionConfig = nil
+	if v, ok := d.GetOk("subscription_config"); ok {
+		subsConfig := v.([]interface{})[0].(map[string]interface{})
+		t.SubscriptionConfig = &tmContentLibrarySubscriptionConfig{
+			SubscriptionUrl:      subsConfig["subscription_url"].(string),
+			Password:             subsConfig["password"].(string),
+			NeedLocalCopy:        !subsConfig["download_content_lazily"].(bool),
+			AutomaticSyncEnabled: subsConfig["sync_mode"].(string) == contentLibrarySyncModeAutomatic,
+		}
+	}
+	if v, ok := d.GetOk("publish_config"); ok && v.([]interface{})[0] != nil {
+		publishConfig := v.([]interface{})[0].(map[string]interface{})
+		t.PublishConfig = &tmContentLibraryPublishConfig{
+			IsPublished:       publishConfig["enabled"].(bool),
+			Password:          publishConfig["password"].(string),
+			PasswordProtected: publishConfig["password"].(string) != "",
+		}
+	} else if d.HasChange("publish_config") {
+		// The block was removed, so the Content Library must not be published anymore
+		t.PublishConfig = &tmContentLibraryPublishConfig{IsPublished: false}
+	}
+	return t
+}
+
+func setContentLibraryData(tmClient *VCDClient, d *schema.ResourceData, cl *govcd.ContentLibrary, origin string) error {
 	if cl == nil || cl.ContentLibrary == nil {
 		return fmt.Errorf("provided %s is nil", labelVcfaContentLibrary)
 	}
@@ -397,17 +522,30 @@ func setContentLibraryData(_ *VCDClient, d *schema.ResourceData, cl *govcd.Conte
 
 	subscriptionConfig := make([]interface{}, 0)
 	if cl.ContentLibrary.SubscriptionConfig != nil {
+		// The synchronization mode is only available in the extended settings of the Content Library
+		extendedCl, err := getTmContentLibrary(tmClient, cl)
+		if err != nil {
+			return err
+		}
+		syncMode := contentLibrarySyncModeOnDemand
+		if extendedCl.SubscriptionConfig != nil && extendedCl.SubscriptionConfig.AutomaticSyncEnabled {
+			syncMode = contentLibrarySyncModeAutomatic
+		}
 		subscriptionConfig = []interface{}{
 			map[string]interface{}{
-				"subscription_url": cl.ContentLibrary.SubscriptionConfig.SubscriptionUrl,
+				"subscription_url":        cl.ContentLibrary.SubscriptionConfig.SubscriptionUrl,
+				"sync_mode":               syncMode,
+				"download_content_lazily": !cl.ContentLibrary.SubscriptionConfig.NeedLocalCopy,
 			},
 		}
-		// Password is only available in resource
+		// Password and synchronization triggers are only available in resource
 		if origin == "resource" {
 			// Password is never returned by backend. We save what we have currently
 			if p := d.Get("subscription_config.0.password"); p != "" {
 				subscriptionConfig[0].(map[string]interface{})["password"] = p
 			}
+			subscriptionConfig[0].(map[string]interface{})["sync_on_create_wait"] = d.Get("subscription_config.0.sync_on_create_wait")
+			subscriptionConfig[0].(map[string]interface{})["sync_trigger"] = d.Get("subscription_config.0.sync_trigger")
 		}
 	}
 
@@ -416,6 +554,70 @@ func setContentLibraryData(_ *VCDClient, d *schema.ResourceData, cl *govcd.Conte
 		return err
 	}
 
+	itemSyncStatus := make([]map[string]interface{}, 0)
+	if cl.ContentLibrary.IsSubscribed {
+		itemSyncStatus, err = getContentLibraryItemSyncStatus(cl)
+		if err != nil {
+			return err
+		}
+	}
+	err = d.Set("item_sync_status", itemSyncStatus)
+	if err != nil {
+		return err
+	}
+
+	publishConfig, err := getContentLibraryPublishConfigData(tmClient, d, cl, origin)
+	if err != nil {
+		return err
+	}
+	err = d.Set("publish_config", publishConfig)
+	if err != nil {
+		return err
+	}
+
+	err = setOpenApiMetadataInState(tmClient, d, types.OpenApiEndpointContentLibraries, cl.ContentLibrary.ID, getContentLibraryTenantHeaders(cl.ContentLibrary.Org))
+	if err != nil {
+		return err
+	}
+
 	d.SetId(cl.ContentLibrary.ID)
 	return nil
 }
+
+// getContentLibraryPublishConfigData returns the 'publish_config' block of the given Content Library. Subscribed Content
+// Libraries cannot be published, so their extended settings are not retrieved
+func getContentLibraryPublishConfigData(tmClient *VCDClient, d *schema.ResourceData, cl *govcd.ContentLibrary, origin string) ([]interface{}, error) {
+	publishConfig := make([]interface{}, 0)
+	if cl.ContentLibrary.IsSubscribed || cl.ContentLibrary.SubscriptionConfig != nil {
+		return publishConfig, nil
+	}
+	extendedCl, err := getTmContentLibrary(tmClient, cl)
+	if err != nil {
+		return nil, err
+	}
+	// A Content Library that is not published is only represented with a block if it is explicitly disabled in configuration
+	if pc := extendedCl.PublishConfig; pc != nil && (pc.IsPublished || len(d.Get("publish_config").([]interface{})) > 0) {
+		subscribers := make([]map[string]interface{}, 0)
+		if pc.IsPublished {
+			subscribers, err = getContentLibrarySubscribers(tmClient, cl)
+			if err != nil {
+				return nil, err
+			}
+		}
+		publishConfig = []interface{}{
+			map[string]interface{}{
+				"enabled":     pc.IsPublished,
+				"publish_url": pc.PublishUrl,
+				"subscribers": subscribers,
+			},
+		}
+		// Password is only available in resource
+		if origin == "resource" {
+			// Password is never returned by backend. We save what we have currently
+			if p := d.Get("publish_config.0.password"); p != "" {
+				publishConfig[0].(map[string]interface{})["password"] = p
+			}
+		}
+	}
+	return publishConfig, nil
+}
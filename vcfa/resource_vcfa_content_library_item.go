@@ -7,11 +7,17 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"log"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 	"github.com/vmware/go-vcloud-director/v3/types/v56"
 )
@@ -27,6 +33,15 @@ func resourceVcfaContentLibraryItem() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceVcfaContentLibraryItemImport,
 		},
+		CustomizeDiff: customdiff.Sequence(resourceVcfaContentLibraryItemCustomizeDiff, validateOpenApiMetadataDiff),
+		Timeouts: &schema.ResourceTimeout{
+			// Only used when waiting for the Content Library Item to leave quarantine, see 'quarantine_release_wait'
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validateContentLibraryItemMissingFiles,
+			validateContentLibraryItemUnreferencedFiles,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -46,20 +61,106 @@ func resourceVcfaContentLibraryItem() *schema.Resource {
 				Description: fmt.Sprintf("ID of the %s that this %s belongs to", labelVcfaContentLibrary, labelVcfaContentLibraryItem),
 			},
 			"file_paths": {
-				Type:        schema.TypeSet,
-				Optional:    true, // Not needed when Importing
-				ForceNew:    true,
-				Description: fmt.Sprintf("A single path to an OVA/ISO, or multiple paths for an OVF and its referenced files, to create the %s", labelVcfaContentLibraryItem),
+				Type:          schema.TypeSet,
+				Optional:      true, // Not needed when Importing
+				Description:   fmt.Sprintf("A single path to an OVA/ISO, or multiple paths for an OVF and its referenced files, to create the %s. A change in their content uploads a new version", labelVcfaContentLibraryItem),
+				ConflictsWith: []string{"source_url", "oci_reference"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"source_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   fmt.Sprintf("HTTP(S) URL of an OVA/ISO that is streamed into the %s without storing it on disk. A change uploads a new version", labelVcfaContentLibraryItem),
+				ConflictsWith: []string{"file_paths", "oci_reference"},
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+			},
+			"source_checksum": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "SHA-256 checksum of the file in 'source_url', with an optional 'sha256:' prefix. It is verified before the upload finishes",
+				RequiredWith:  []string{"source_url"},
+				ConflictsWith: []string{"oci_reference"},
+				ValidateFunc:  validation.StringMatch(sourceChecksumRegex, "must be a SHA-256 checksum in hexadecimal format, with an optional 'sha256:' prefix"),
+			},
+			"source_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "HTTP headers sent when downloading 'source_url' or 'oci_reference', like 'Authorization'",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_allow_unverified_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to accept invalid or self-signed TLS certificates when downloading 'source_url' or 'oci_reference'. The provider 'allow_unverified_ssl' setting does not apply to them",
+			},
+			"oci_reference": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   fmt.Sprintf("Reference to an OCI artifact, in the format '<registry>/<repository>:<tag>' or '<registry>/<repository>@<digest>', with a layer that contains an OVA/ISO to stream into the %s. A change uploads a new version", labelVcfaContentLibraryItem),
+				ConflictsWith: []string{"file_paths", "source_url"},
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, _, _, err := parseOciReference(i.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s: %s", k, err)}
+					}
+					return nil, nil
+				},
+			},
 			"upload_piece_size": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     1,
 				Description: fmt.Sprintf("When uploading the %s, this argument defines the size of the file chunks in which it is split on every upload request. It can possibly impact upload performance. Default 1 MB", labelVcfaContentLibraryItem),
 			},
+			"upload_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  fmt.Sprintf("When uploading the %s, the amount of file chunks that are uploaded at the same time. Default 1", labelVcfaContentLibraryItem),
+			},
+			"upload_progress_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  fmt.Sprintf("When uploading the %s, how often the upload progress is written to the DEBUG logs, in seconds. Default 30", labelVcfaContentLibraryItem),
+			},
+			"upload_journal_directory": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ".terraform/vcfa-upload-journals",
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description: fmt.Sprintf("When uploading the %s, the directory where the chunks received by VCFA are recorded, so interrupted "+
+					"uploads can be resumed. Relative paths start in the working directory. Default '.terraform/vcfa-upload-journals'", labelVcfaContentLibraryItem),
+			},
+			"quarantine_release_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: fmt.Sprintf("Whether to wait during creation until the %s leaves quarantine, when the %s quarantines new items. "+
+					"If the %s is rejected, the creation fails", labelVcfaContentLibraryItem, labelVcfaOrg, labelVcfaContentLibraryItem),
+			},
+			"file_checksums": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: fmt.Sprintf("SHA-256 checksums of the files in 'file_paths' that were uploaded to the %s, indexed by file name", labelVcfaContentLibraryItem),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"file_fingerprints": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Size and modification time of the files in 'file_paths' when their checksums were calculated, indexed by file name",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"creation_date": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -100,11 +201,18 @@ func resourceVcfaContentLibraryItem() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Status of this %s", labelVcfaContentLibraryItem),
 			},
+			"quarantine_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf("Quarantine state of this %s, one of '%s', '%s' or '%s'. Empty if it is not affected by quarantine",
+					labelVcfaContentLibraryItem, contentLibraryItemStatusQuarantined, contentLibraryItemStatusQuarantineExpire, contentLibraryItemStatusRejected),
+			},
 			"version": {
 				Type:        schema.TypeInt,
 				Computed:    true,
 				Description: fmt.Sprintf("The version of this %s. For a subscribed library, this version is same as in publisher library", labelVcfaContentLibraryItem),
 			},
+			"metadata": openApiMetadataSchema(false),
 		},
 	}
 }
@@ -118,10 +226,103 @@ func resourceVcfaContentLibraryItemCreate(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibrary, clId, err)
 	}
 
+	if contentLibraryItemHasSource(d) {
+		return createContentLibraryItemFromSourceConfig(ctx, d, meta, cl)
+	}
 	if _, ok := d.GetOk("file_paths"); !ok {
-		return diag.Errorf("the argument 'file_paths' is required during creation")
+		return diag.Errorf("one of the arguments 'file_paths', 'source_url' or 'oci_reference' is required during creation")
+	}
+
+	uploadArgs, err := getContentLibraryItemUploadArguments(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	filePaths := convertSchemaSetToSliceOfStrings(d.Get("file_paths").(*schema.Set))
+	fingerprints, missing, err := contentLibraryItemFingerprints(filePaths)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(missing) > 0 {
+		return diag.Errorf("the files %v do not exist", missing)
+	}
+	checksums, err := contentLibraryItemChecksums(filePaths)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Paths that depend on other resources are only known during apply, so they are validated here as well
+	err = validateContentLibraryItemFiles(filePaths, checksums)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	c := crudConfig[*govcd.ContentLibraryItem, types.ContentLibraryItem]{
+		entityLabel:    labelVcfaContentLibraryItem,
+		getTypeFunc:    getContentLibraryItemType,
+		stateStoreFunc: setContentLibraryItemData,
+		createFunc: func(config *types.ContentLibraryItem) (*govcd.ContentLibraryItem, error) {
+			cli, err := createContentLibraryItemFromFiles(tmClient, cl, config, uploadArgs, getContentLibraryItemUploadOptions(d))
+			if err != nil {
+				return nil, err
+			}
+			dSet(d, "file_checksums", checksums)
+			dSet(d, "file_fingerprints", fingerprints)
+			return cli, nil
+		},
+		postCreateHooks:  contentLibraryItemPostCreateHooks(ctx, tmClient, d),
+		resourceReadFunc: resourceVcfaContentLibraryItemRead,
+	}
+	return createResource(ctx, d, meta, c)
+}
+
+// createContentLibraryItemFromSourceConfig creates the Content Library Item streaming the file from 'source_url' or
+// 'oci_reference'
+func createContentLibraryItemFromSourceConfig(ctx context.Context, d *schema.ResourceData, meta interface{}, cl *govcd.ContentLibrary) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	src, err := openContentLibraryItemSourceConfig(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer src.close()
+
+	c := crudConfig[*govcd.ContentLibraryItem, types.ContentLibraryItem]{
+		entityLabel:    labelVcfaContentLibraryItem,
+		getTypeFunc:    getContentLibraryItemType,
+		stateStoreFunc: setContentLibraryItemData,
+		createFunc: func(config *types.ContentLibraryItem) (*govcd.ContentLibraryItem, error) {
+			cli, checksum, err := createContentLibraryItemFromSource(tmClient, cl, config, src, getContentLibraryItemUploadOptions(d))
+			if err != nil {
+				return nil, err
+			}
+			dSet(d, "file_checksums", map[string]string{src.name: checksum})
+			return cli, nil
+		},
+		postCreateHooks:  contentLibraryItemPostCreateHooks(ctx, tmClient, d),
+		resourceReadFunc: resourceVcfaContentLibraryItemRead,
 	}
+	return createResource(ctx, d, meta, c)
+}
+
+// openContentLibraryItemSourceConfig starts the download of the source configured in 'source_url' or 'oci_reference'
+func openContentLibraryItemSourceConfig(d *schema.ResourceData) (*contentLibraryItemSource, error) {
+	client := newContentLibraryItemSourceHttpClient(d.Get("source_allow_unverified_ssl").(bool))
+	headers := map[string]string{}
+	for k, v := range d.Get("source_headers").(map[string]interface{}) {
+		headers[k] = v.(string)
+	}
+	if ref, ok := d.GetOk("oci_reference"); ok {
+		return openContentLibraryItemOciSource(client, ref.(string), headers)
+	}
+	return openContentLibraryItemUrlSource(client, d.Get("source_url").(string), headers, d.Get("source_checksum").(string))
+}
 
+// contentLibraryItemHasSource returns true if the Content Library Item is streamed from 'source_url' or 'oci_reference'
+func contentLibraryItemHasSource(d interface{ Get(string) interface{} }) bool {
+	return d.Get("source_url").(string) != "" || d.Get("oci_reference").(string) != ""
+}
+
+// getContentLibraryItemUploadArguments builds the upload arguments from the 'file_paths' and 'upload_piece_size' arguments
+func getContentLibraryItemUploadArguments(d *schema.ResourceData) (govcd.ContentLibraryItemUploadArguments, error) {
 	uploadArgs := govcd.ContentLibraryItemUploadArguments{
 		UploadPieceSize: int64(d.Get("upload_piece_size").(int)) * 1024 * 1024,
 	}
@@ -130,37 +331,104 @@ func resourceVcfaContentLibraryItemCreate(ctx context.Context, d *schema.Resourc
 	if len(filePaths) == 1 {
 		p := filepath.Clean(filePaths[0].(string))
 		if filepath.Ext(p) != ".iso" && filepath.Ext(p) != ".ova" {
-			return diag.Errorf("when uploading a single file, only ISO/OVA is supported. OVF requires multiple files")
+			return uploadArgs, fmt.Errorf("when uploading a single file, only ISO/OVA is supported. OVF requires multiple files")
 		}
 		// ISO/OVA
 		uploadArgs.FilePath = p
-	} else {
-		// OVF. We have to search for the descriptor.ovf inside the TypeSet.
-		ovfFound := false
-		for _, p := range filePaths {
-			cleanedPath := filepath.Clean(p.(string))
-			if filepath.Ext(cleanedPath) == ".ovf" {
-				uploadArgs.FilePath = cleanedPath
-				ovfFound = true
-			} else {
-				uploadArgs.OvfFilesPaths = append(uploadArgs.OvfFilesPaths, cleanedPath)
+		return uploadArgs, nil
+	}
+
+	// OVF. We have to search for the descriptor.ovf inside the TypeSet.
+	ovfFound := false
+	for _, p := range filePaths {
+		cleanedPath := filepath.Clean(p.(string))
+		if filepath.Ext(cleanedPath) == ".ovf" {
+			uploadArgs.FilePath = cleanedPath
+			ovfFound = true
+		} else {
+			uploadArgs.OvfFilesPaths = append(uploadArgs.OvfFilesPaths, cleanedPath)
+		}
+	}
+	if !ovfFound {
+		return uploadArgs, fmt.Errorf("could not find the 'descriptor.ovf' file in any of the provided paths: %v", filePaths)
+	}
+	return uploadArgs, nil
+}
+
+// resourceVcfaContentLibraryItemCustomizeDiff calculates the checksums of the files in 'file_paths', so a change in
+// their content is planned as an upload of a new version of the Content Library Item
+func resourceVcfaContentLibraryItemCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// Remote sources are not downloaded during plan, so any change in them uploads a new version
+	if d.Id() != "" && d.HasChanges("source_url", "source_checksum", "oci_reference") && contentLibraryItemHasSource(d) {
+		if err := d.SetNewComputed("version"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("file_checksums")
+	}
+	if !d.NewValueKnown("file_paths") {
+		// Checksums are calculated during apply
+		if d.Id() != "" {
+			if err := d.SetNewComputed("version"); err != nil {
+				return err
 			}
 		}
-		if !ovfFound {
-			return diag.Errorf("could not find the 'descriptor.ovf' file in any of the provided paths: %v", filePaths)
+		if err := d.SetNewComputed("file_fingerprints"); err != nil {
+			return err
 		}
+		return d.SetNewComputed("file_checksums")
+	}
+	filePaths := convertSchemaSetToSliceOfStrings(d.Get("file_paths").(*schema.Set))
+	if len(filePaths) == 0 {
+		// Imported Content Library Items may not have files
+		return nil
 	}
 
-	c := crudConfig[*govcd.ContentLibraryItem, types.ContentLibraryItem]{
-		entityLabel:    labelVcfaContentLibraryItem,
-		getTypeFunc:    getContentLibraryItemType,
-		stateStoreFunc: setContentLibraryItemData,
-		createFunc: func(config *types.ContentLibraryItem) (*govcd.ContentLibraryItem, error) {
-			return cl.CreateContentLibraryItem(config, uploadArgs)
-		},
-		resourceReadFunc: resourceVcfaContentLibraryItemRead,
+	fingerprints, missing, err := contentLibraryItemFingerprints(filePaths)
+	if err != nil {
+		return fmt.Errorf("file_paths: %s", err)
 	}
-	return createResource(ctx, d, meta, c)
+	if len(missing) > 0 {
+		if d.Id() == "" {
+			return fmt.Errorf("file_paths: the files %v do not exist", missing)
+		}
+		// The files may have been removed after the upload, i.e. by CI runners, so the uploaded content is kept
+		log.Printf("[WARN] the files %v of %s '%s' do not exist, keeping the stored checksums", missing, labelVcfaContentLibraryItem, d.Id())
+		return nil
+	}
+	oldFingerprints := d.Get("file_fingerprints").(map[string]interface{})
+	if d.Id() != "" && !d.HasChange("file_paths") && fingerprintsEqual(oldFingerprints, fingerprints) {
+		// Files with the same size and modification time are not read again, as they can be very large
+		return nil
+	}
+
+	checksums, err := contentLibraryItemChecksums(filePaths)
+	if err != nil {
+		return fmt.Errorf("file_paths: %s", err)
+	}
+	err = validateContentLibraryItemFiles(filePaths, checksums)
+	if err != nil {
+		return fmt.Errorf("file_paths: %s", err)
+	}
+	newChecksums := make(map[string]interface{}, len(checksums))
+	for k, v := range checksums {
+		newChecksums[k] = v
+	}
+
+	oldChecksums := d.Get("file_checksums").(map[string]interface{})
+	if checksumsContentEqual(oldChecksums, newChecksums) {
+		return nil
+	}
+	if err := d.SetNew("file_checksums", newChecksums); err != nil {
+		return err
+	}
+	if err := d.SetNew("file_fingerprints", fingerprints); err != nil {
+		return err
+	}
+	// Items without recorded checksums (i.e. imported) just store them, as their uploaded content is unknown
+	if d.Id() != "" && len(oldChecksums) > 0 {
+		return d.SetNewComputed("version")
+	}
+	return nil
 }
 
 func resourceVcfaContentLibraryItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -172,6 +440,39 @@ func resourceVcfaContentLibraryItemUpdate(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("could not retrieve Content Library with ID '%s': %s", clId, err)
 	}
 
+	if d.HasChanges("source_url", "source_checksum", "oci_reference") && contentLibraryItemHasSource(d) {
+		cli, err := cl.GetContentLibraryItemById(d.Id())
+		if err != nil {
+			return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibraryItem, d.Id(), err)
+		}
+		src, err := openContentLibraryItemSourceConfig(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer src.close()
+		checksum, err := uploadContentLibraryItemNewVersionFromSource(tmClient, cli, src, getContentLibraryItemUploadOptions(d))
+		if err != nil {
+			return diag.Errorf("error uploading a new version of %s '%s': %s", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+		}
+		dSet(d, "file_checksums", map[string]string{src.name: checksum})
+	} else if d.HasChanges("file_paths", "file_checksums") {
+		err = updateContentLibraryItemFiles(tmClient, cl, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		cli, err := cl.GetContentLibraryItemById(d.Id())
+		if err != nil {
+			return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibraryItem, d.Id(), err)
+		}
+		err = updateContentLibraryItemMetadata(tmClient, d, cli)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	c := crudConfig[*govcd.ContentLibraryItem, types.ContentLibraryItem]{
 		entityLabel:      labelVcfaContentLibraryItem,
 		getTypeFunc:      getContentLibraryItemType,
@@ -182,6 +483,77 @@ func resourceVcfaContentLibraryItemUpdate(ctx context.Context, d *schema.Resourc
 	return updateResource(ctx, d, meta, c)
 }
 
+// updateContentLibraryItemFiles uploads a new version of the Content Library Item when the content of the files in
+// 'file_paths' changed. Checksums are calculated again, as they are not known during plan when 'file_paths' depends on
+// other resources.
+func updateContentLibraryItemFiles(tmClient *VCDClient, cl *govcd.ContentLibrary, d *schema.ResourceData) error {
+	filePaths := convertSchemaSetToSliceOfStrings(d.Get("file_paths").(*schema.Set))
+	fingerprints, missing, err := contentLibraryItemFingerprints(filePaths)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		// The uploaded content cannot be compared with files that do not exist, so the stored checksums are kept
+		log.Printf("[WARN] the files %v of %s '%s' do not exist, keeping the stored checksums", missing, labelVcfaContentLibraryItem, d.Id())
+		return nil
+	}
+	checksums, err := contentLibraryItemChecksums(filePaths)
+	if err != nil {
+		return err
+	}
+	newChecksums := make(map[string]interface{}, len(checksums))
+	for k, v := range checksums {
+		newChecksums[k] = v
+	}
+
+	// Items without recorded checksums (i.e. imported) just store them, as their uploaded content is unknown.
+	// Removing 'file_paths' does not modify the uploaded content either.
+	oldChecksums, _ := d.GetChange("file_checksums")
+	if len(oldChecksums.(map[string]interface{})) > 0 && len(newChecksums) > 0 && !checksumsContentEqual(oldChecksums.(map[string]interface{}), newChecksums) {
+		err = validateContentLibraryItemFiles(filePaths, checksums)
+		if err != nil {
+			return err
+		}
+		uploadArgs, err := getContentLibraryItemUploadArguments(d)
+		if err != nil {
+			return err
+		}
+		cli, err := cl.GetContentLibraryItemById(d.Id())
+		if err != nil {
+			return fmt.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibraryItem, d.Id(), err)
+		}
+		err = uploadContentLibraryItemNewVersion(tmClient, cli, uploadArgs, getContentLibraryItemUploadOptions(d))
+		if err != nil {
+			return fmt.Errorf("error uploading a new version of %s '%s': %s", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+		}
+	}
+	dSet(d, "file_checksums", newChecksums)
+	dSet(d, "file_fingerprints", fingerprints)
+	return nil
+}
+
+// validateContentLibraryItemMissingFiles warns about the files in 'file_paths' that do not exist. New Content Library
+// Items cannot be created without them, but existing ones keep their uploaded content, as the files may have been
+// removed after the upload.
+func validateContentLibraryItemMissingFiles(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	filePaths, ok := getRawConfigFilePaths(req.RawConfig)
+	if !ok {
+		return
+	}
+	for _, p := range filePaths {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			continue
+		}
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "File not found",
+			Detail: fmt.Sprintf("'%s' does not exist. A new %s cannot be created without it, but an existing one keeps its uploaded content",
+				p, labelVcfaContentLibraryItem),
+			AttributePath: cty.GetAttrPath("file_paths"),
+		})
+	}
+}
+
 func resourceVcfaContentLibraryItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 
@@ -274,7 +646,41 @@ func getContentLibraryItemType(_ *VCDClient, d *schema.ResourceData) (*types.Con
 	return t, nil
 }
 
-func setContentLibraryItemData(_ *VCDClient, d *schema.ResourceData, cli *govcd.ContentLibraryItem) error {
+// contentLibraryItemPostCreateHooks returns the hooks to run once a Content Library Item is created
+func contentLibraryItemPostCreateHooks(ctx context.Context, tmClient *VCDClient, d *schema.ResourceData) []outerEntityHook[*govcd.ContentLibraryItem] {
+	return []outerEntityHook[*govcd.ContentLibraryItem]{
+		contentLibraryItemMetadataHook(tmClient, d),
+		func(cli *govcd.ContentLibraryItem) error {
+			if !d.Get("quarantine_release_wait").(bool) || !isContentLibraryItemQuarantined(cli.ContentLibraryItem.Status) {
+				return nil
+			}
+			return waitForContentLibraryItemQuarantineRelease(ctx, tmClient, cli, d.Timeout(schema.TimeoutCreate))
+		},
+	}
+}
+
+// contentLibraryItemMetadataHook sets the metadata of a Content Library Item after it is created. The ID is stored first,
+// so the Content Library Item is tainted if this fails
+func contentLibraryItemMetadataHook(tmClient *VCDClient, d *schema.ResourceData) outerEntityHook[*govcd.ContentLibraryItem] {
+	return func(cli *govcd.ContentLibraryItem) error {
+		d.SetId(cli.ContentLibraryItem.ID)
+		return updateContentLibraryItemMetadata(tmClient, d, cli)
+	}
+}
+
+// updateContentLibraryItemMetadata makes the metadata of the given Content Library Item match the 'metadata' argument
+func updateContentLibraryItemMetadata(tmClient *VCDClient, d *schema.ResourceData, cli *govcd.ContentLibraryItem) error {
+	if !d.HasChange("metadata") {
+		return nil
+	}
+	err := updateOpenApiMetadata(tmClient, d, types.OpenApiEndpointContentLibraryItems, cli.ContentLibraryItem.ID, getContentLibraryTenantHeaders(cli.ContentLibraryItem.Org))
+	if err != nil {
+		return fmt.Errorf("error setting metadata of %s '%s': %s", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, err)
+	}
+	return nil
+}
+
+func setContentLibraryItemData(tmClient *VCDClient, d *schema.ResourceData, cli *govcd.ContentLibraryItem) error {
 	if cli == nil || cli.ContentLibraryItem == nil {
 		return fmt.Errorf("cannot save state for nil %s", labelVcfaContentLibraryItem)
 	}
@@ -292,7 +698,13 @@ func setContentLibraryItemData(_ *VCDClient, d *schema.ResourceData, cli *govcd.
 		dSet(d, "owner_org_id", cli.ContentLibraryItem.Org.ID)
 	}
 	dSet(d, "status", cli.ContentLibraryItem.Status)
+	dSet(d, "quarantine_state", getContentLibraryItemQuarantineState(cli.ContentLibraryItem.Status))
 	dSet(d, "version", cli.ContentLibraryItem.Version)
+
+	err := setOpenApiMetadataInState(tmClient, d, types.OpenApiEndpointContentLibraryItems, cli.ContentLibraryItem.ID, getContentLibraryTenantHeaders(cli.ContentLibraryItem.Org))
+	if err != nil {
+		return err
+	}
 	d.SetId(cli.ContentLibraryItem.ID)
 
 	return nil
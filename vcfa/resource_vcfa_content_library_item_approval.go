@@ -0,0 +1,134 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+const labelVcfaContentLibraryItemApproval = "Content Library Item Approval"
+
+func resourceVcfaContentLibraryItemApproval() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaContentLibraryItemApprovalCreate,
+		ReadContext:   resourceVcfaContentLibraryItemApprovalRead,
+		DeleteContext: resourceVcfaContentLibraryItemApprovalDelete,
+		Timeouts: &schema.ResourceTimeout{
+			// Only used when waiting for the approved Content Library Item to be ready, see 'ready_wait'
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_library_item_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the quarantined %s to approve or reject", labelVcfaContentLibraryItem),
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{contentLibraryItemApprovalActionApprove, contentLibraryItemApprovalActionReject}, false),
+				Description: fmt.Sprintf("Whether to '%s' or '%s' the %s", contentLibraryItemApprovalActionApprove,
+					contentLibraryItemApprovalActionReject, labelVcfaContentLibraryItem),
+			},
+			"reason": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Reason of the decision, which is shown to the owner of the %s", labelVcfaContentLibraryItem),
+			},
+			"ready_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+				Description: fmt.Sprintf("Whether to wait until the approved %s finishes its upload and is ready to be used. "+
+					"Ignored when the %s is rejected", labelVcfaContentLibraryItem, labelVcfaContentLibraryItem),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Status of the %s", labelVcfaContentLibraryItem),
+			},
+			"quarantine_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Quarantine state of the %s. Empty if it is not affected by quarantine", labelVcfaContentLibraryItem),
+			},
+		},
+	}
+}
+
+func resourceVcfaContentLibraryItemApprovalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	cliId := d.Get("content_library_item_id").(string)
+	cli, err := tmClient.GetContentLibraryItemById(cliId)
+	if err != nil {
+		return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibraryItem, cliId, err)
+	}
+
+	action := d.Get("action").(string)
+	if !isContentLibraryItemQuarantined(cli.ContentLibraryItem.Status) {
+		// Approving an item that is not quarantined anymore is harmless, so Terraform runs can be repeated
+		if action != contentLibraryItemApprovalActionApprove || getContentLibraryItemQuarantineState(cli.ContentLibraryItem.Status) != "" {
+			return diag.Errorf("cannot %s %s '%s' as it is not quarantined, its status is '%s'", action,
+				labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, cli.ContentLibraryItem.Status)
+		}
+		log.Printf("[DEBUG] %s '%s' is not quarantined, nothing to approve", labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name)
+	} else {
+		err = reviewContentLibraryItem(tmClient, cli, action, d.Get("reason").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(cli.ContentLibraryItem.ID)
+
+	if action == contentLibraryItemApprovalActionApprove && d.Get("ready_wait").(bool) {
+		err = waitForContentLibraryItemQuarantineRelease(ctx, tmClient, cli, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceVcfaContentLibraryItemApprovalRead(ctx, d, meta)
+}
+
+func resourceVcfaContentLibraryItemApprovalRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	cli, err := tmClient.GetContentLibraryItemById(d.Id())
+	if govcd.ContainsNotFound(err) {
+		// Rejected items can be removed by VCFA
+		log.Printf("[DEBUG] %s '%s' no longer exists. Removing %s from tfstate", labelVcfaContentLibraryItem, d.Id(), labelVcfaContentLibraryItemApproval)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibraryItem, d.Id(), err)
+	}
+
+	dSet(d, "content_library_item_id", cli.ContentLibraryItem.ID)
+	dSet(d, "status", cli.ContentLibraryItem.Status)
+	dSet(d, "quarantine_state", getContentLibraryItemQuarantineState(cli.ContentLibraryItem.Status))
+	return nil
+}
+
+func resourceVcfaContentLibraryItemApprovalDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// An approval or rejection cannot be undone, so it is only removed from state
+	log.Printf("[INFO] %s of '%s' cannot be undone, it is only removed from state. The %s keeps its status '%s'",
+		labelVcfaContentLibraryItemApproval, d.Id(), labelVcfaContentLibraryItem, d.Get("status").(string))
+	d.SetId("")
+	return nil
+}
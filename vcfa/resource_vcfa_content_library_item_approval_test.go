@@ -0,0 +1,146 @@
+//go:build tm || contentlibrary || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaContentLibraryItemApproval tests the approval and rejection of Content Library Items that are quarantined
+// in an Organization
+func TestAccVcfaContentLibraryItemApproval(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	vmClassesHcl, vmClassesRefs := getRegionVmClassesHcl(t, regionHclRef)
+
+	var params = StringMap{
+		"Org":                t.Name(),
+		"Username":           "test-user",
+		"Password":           "long-change-ME1",
+		"Name":               t.Name(),
+		"RegionId":           fmt.Sprintf("%s.id", regionHclRef),
+		"SupervisorName":     testConfig.Tm.VcenterSupervisor,
+		"SupervisorZoneName": testConfig.Tm.VcenterSupervisorZone,
+		"StorageClass":       testConfig.Tm.StorageClass,
+		"VcenterRef":         vCenterHclRef,
+		"RegionVmClassRefs":  strings.Join(vmClassesRefs, ".id,\n    ") + ".id",
+		"IsoPath":            getTestingResourcesAbsolutePaths(t, contentLibraryItemTestingResourcePaths)[1],
+		"Tags":               "tm contentlibrary",
+	}
+	testParamsNotEmpty(t, params)
+
+	// The Organization quarantines all the uploaded Content Library Items
+	quarantinePrerequisites := strings.Replace(testAccVcfaContentLibraryTenantPrerequisites,
+		"quarantine_content_library_items       = false", "quarantine_content_library_items       = true", 1)
+	preRequisites := vCenterHcl + nsxManagerHcl + regionHcl + vmClassesHcl + quarantinePrerequisites
+
+	configText1 := templateFill(preRequisites+testAccVcfaContentLibraryItemApprovalStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(preRequisites+testAccVcfaContentLibraryItemApprovalStep2, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	approved := "vcfa_content_library_item.approved"
+	rejected := "vcfa_content_library_item.rejected"
+	approval := "vcfa_content_library_item_approval.approve"
+	rejection := "vcfa_content_library_item_approval.reject"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(approved, "status", "QUARANTINED"),
+					resource.TestCheckResourceAttr(approved, "quarantine_state", "QUARANTINED"),
+					resource.TestCheckResourceAttr(rejected, "status", "QUARANTINED"),
+					resource.TestCheckResourceAttr(rejected, "quarantine_state", "QUARANTINED"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(approval, "id", approved, "id"),
+					resource.TestCheckResourceAttr(approval, "status", "READY"),
+					resource.TestCheckResourceAttr(approval, "quarantine_state", ""),
+					resource.TestCheckResourceAttrPair(rejection, "id", rejected, "id"),
+					resource.TestCheckResourceAttr(rejection, "quarantine_state", "REJECTED"),
+				),
+			},
+			{
+				// Refreshes the Content Library Items after they were reviewed
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(approved, "status", "READY"),
+					resource.TestCheckResourceAttr(approved, "quarantine_state", ""),
+					resource.TestCheckResourceAttrSet(approved, "image_identifier"),
+					resource.TestCheckResourceAttr(rejected, "quarantine_state", "REJECTED"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaContentLibraryItemApprovalStep1 = `
+data "vcfa_storage_class" "sc" {
+  region_id = {{.RegionId}}
+  name      = data.vcfa_region_storage_policy.sp.name
+}
+
+resource "vcfa_content_library" "cl" {
+  org_id      = vcfa_org_region_quota.test.org_id # Explicit dependency on Region Quota
+  name        = "{{.Name}}"
+  description = "{{.Name}}"
+  storage_class_ids = [
+    data.vcfa_storage_class.sc.id
+  ]
+  delete_recursive = true
+
+  # Items must be uploaded once quarantine is enabled
+  depends_on = [vcfa_org_settings.allow]
+}
+
+resource "vcfa_content_library_item" "approved" {
+  name               = "{{.Name}}Approved"
+  content_library_id = vcfa_content_library.cl.id
+  file_paths         = ["{{.IsoPath}}"]
+}
+
+resource "vcfa_content_library_item" "rejected" {
+  name               = "{{.Name}}Rejected"
+  content_library_id = vcfa_content_library.cl.id
+  file_paths         = ["{{.IsoPath}}"]
+}
+`
+
+const testAccVcfaContentLibraryItemApprovalStep2 = testAccVcfaContentLibraryItemApprovalStep1 + `
+resource "vcfa_content_library_item_approval" "approve" {
+  content_library_item_id = vcfa_content_library_item.approved.id
+  action                  = "APPROVE"
+  reason                  = "Passed the file inspection"
+}
+
+resource "vcfa_content_library_item_approval" "reject" {
+  content_library_item_id = vcfa_content_library_item.rejected.id
+  action                  = "REJECT"
+  reason                  = "Did not pass the file inspection"
+}
+`
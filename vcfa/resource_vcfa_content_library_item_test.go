@@ -8,12 +8,17 @@ package vcfa
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 var contentLibraryItemTestingResourcePaths = []string{
@@ -148,9 +153,17 @@ func TestAccVcfaContentLibraryItemProvider(t *testing.T) {
 				Config: configText3,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// file_paths and upload_piece_size cannot be obtained during reads, that's why it does not appear in data source schema
-					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "%"}),
+					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.test_vapp_template.ova", "file_fingerprints.%", "file_fingerprints.test_vapp_template.ova"}),
+					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.test.iso", "file_fingerprints.%", "file_fingerprints.test.iso"}),
+					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.descriptor.ovf", "file_checksums.disk1.vmdk", "file_fingerprints.%", "file_fingerprints.descriptor.ovf", "file_fingerprints.disk1.vmdk"}),
+
+					// Plural data source
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.templates", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.templates", "items.0.item_type", "TEMPLATE"),
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.templates", "items.1.item_type", "TEMPLATE"),
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.most_recent", "items.#", "1"),
+					resource.TestCheckResourceAttrSet("data.vcfa_content_library_items.most_recent", "items.0.image_identifier"),
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.none", "items.#", "0"),
 				),
 			},
 			{
@@ -158,7 +171,7 @@ func TestAccVcfaContentLibraryItemProvider(t *testing.T) {
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateId:           fmt.Sprintf("System%s%s%s%s", ImportSeparator, testConfig.Tm.ContentLibrary, ImportSeparator, params["Name"].(string)+"1"),
-				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
+				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "upload_journal_directory", "source_allow_unverified_ssl", "file_checksums.%", "file_checksums.test_vapp_template.ova", "%", "file_fingerprints.%", "file_fingerprints.test_vapp_template.ova"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
 			},
 		},
 	})
@@ -184,6 +197,8 @@ resource "vcfa_content_library_item" "cli3" {
   description        = "{{.Name}}3"
   content_library_id = {{.ContentLibraryRef}}
   file_paths         = [{{.OvfPaths}}]
+  upload_piece_size  = 1
+  upload_parallelism = 4
 }
 `
 
@@ -200,6 +215,30 @@ data "vcfa_content_library_item" "cli3_ds" {
   name               = vcfa_content_library_item.cli3.name
   content_library_id = vcfa_content_library_item.cli3.content_library_id
 }
+
+data "vcfa_content_library_items" "templates" {
+  content_library_id = {{.ContentLibraryRef}}
+  name_regex         = "^{{.Name}}[0-9]$"
+  item_type          = "TEMPLATE"
+
+  depends_on = [vcfa_content_library_item.cli1, vcfa_content_library_item.cli2, vcfa_content_library_item.cli3]
+}
+
+data "vcfa_content_library_items" "most_recent" {
+  content_library_id = {{.ContentLibraryRef}}
+  name_regex         = "^{{.Name}}[0-9]$"
+  most_recent        = true
+
+  depends_on = [vcfa_content_library_item.cli1, vcfa_content_library_item.cli2, vcfa_content_library_item.cli3]
+}
+
+data "vcfa_content_library_items" "none" {
+  content_library_id = {{.ContentLibraryRef}}
+  name_regex         = "^{{.Name}}[0-9]$"
+  created_before     = "2000-01-01T00:00:00Z"
+
+  depends_on = [vcfa_content_library_item.cli1, vcfa_content_library_item.cli2, vcfa_content_library_item.cli3]
+}
 `
 
 // TestAccVcfaContentLibraryItemTenant tests Content Library Items in a "TENANT" type Content Library
@@ -358,9 +397,9 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 				Config:            configText3,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// file_paths and upload_piece_size cannot be obtained during reads, that's why it does not appear in data source schema
-					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "%"}),
+					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.test_vapp_template.ova", "file_fingerprints.%", "file_fingerprints.test_vapp_template.ova"}),
+					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.test.iso", "file_fingerprints.%", "file_fingerprints.test.iso"}),
+					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.descriptor.ovf", "file_checksums.disk1.vmdk", "file_fingerprints.%", "file_fingerprints.descriptor.ovf", "file_fingerprints.disk1.vmdk"}),
 				),
 			},
 			{
@@ -369,7 +408,7 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateId:           fmt.Sprintf("%s%s%s%s%s", testConfig.Tm.Org, ImportSeparator, testConfig.Tm.ContentLibrary, ImportSeparator, t.Name()+"Updated1"),
-				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
+				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "upload_journal_directory", "source_allow_unverified_ssl", "file_checksums.%", "file_checksums.test_vapp_template.ova", "%", "file_fingerprints.%", "file_fingerprints.test_vapp_template.ova"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
 			},
 			{
 				ProviderFactories: multipleFactories(),
@@ -435,9 +474,9 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 				Config:            configText6,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// file_paths and upload_piece_size cannot be obtained during reads, that's why it does not appear in data source schema
-					resourceFieldsEqual(cli4, "data.vcfa_content_library_item.cli4_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli5, "data.vcfa_content_library_item.cli5_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli6, "data.vcfa_content_library_item.cli6_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "%"}),
+					resourceFieldsEqual(cli4, "data.vcfa_content_library_item.cli4_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.test_vapp_template.ova", "file_fingerprints.%", "file_fingerprints.test_vapp_template.ova"}),
+					resourceFieldsEqual(cli5, "data.vcfa_content_library_item.cli5_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.test.iso", "file_fingerprints.%", "file_fingerprints.test.iso"}),
+					resourceFieldsEqual(cli6, "data.vcfa_content_library_item.cli6_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "%", "file_checksums.%", "file_checksums.descriptor.ovf", "file_checksums.disk1.vmdk", "file_fingerprints.%", "file_fingerprints.descriptor.ovf", "file_fingerprints.disk1.vmdk"}),
 				),
 			},
 		},
@@ -491,3 +530,525 @@ data "vcfa_content_library_item" "cli6_ds" {
   content_library_id = vcfa_content_library_item.cli6.content_library_id
 }
 `
+
+// TestAccVcfaContentLibraryItemNewVersion tests that changing the content of the uploaded files creates a new version
+// of the Content Library Item instead of replacing it
+func TestAccVcfaContentLibraryItemNewVersion(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	contentLibraryHcl, contentLibraryHclRef := getContentLibraryHcl(t, regionHclRef, "")
+
+	// The ISO file is copied, so its content can be modified between steps
+	itemPaths := getTestingResourcesAbsolutePaths(t, contentLibraryItemTestingResourcePaths)
+	isoContent, err := os.ReadFile(itemPaths[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	isoPath := filepath.Join(t.TempDir(), "test.iso")
+	err = os.WriteFile(isoPath, isoContent, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var params = StringMap{
+		"Name":              t.Name(),
+		"ContentLibraryRef": fmt.Sprintf("%s.id", contentLibraryHclRef),
+		"IsoPath":           isoPath,
+		"Tags":              "tm contentlibrary",
+	}
+	testParamsNotEmpty(t, params)
+
+	preRequisites := vCenterHcl + nsxManagerHcl + regionHcl + contentLibraryHcl
+
+	configText1 := templateFill(preRequisites+testAccVcfaContentLibraryItemNewVersion, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(preRequisites+testAccVcfaContentLibraryItemNewVersion, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	cli := "vcfa_content_library_item.iso"
+	cachedId := &testCachedFieldValue{}
+	cachedChecksum := &testCachedFieldValue{}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.cacheTestResourceFieldValue(cli, "id"),
+					cachedChecksum.cacheTestResourceFieldValue(cli, "file_checksums.test.iso"),
+					resource.TestCheckResourceAttr(cli, "version", "1"),
+					resource.TestCheckResourceAttr(cli, "file_checksums.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					// Append some bytes to change the content of the ISO file
+					err := os.WriteFile(isoPath, append(isoContent, make([]byte, 4096)...), 0600)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.testCheckCachedResourceFieldValue(cli, "id"),
+					resource.TestCheckResourceAttr(cli, "version", "2"),
+					resource.TestCheckResourceAttr(cli, "file_checksums.%", "1"),
+					func(s *terraform.State) error {
+						if cachedChecksum.testCheckCachedResourceFieldValue(cli, "file_checksums.test.iso")(s) == nil {
+							return fmt.Errorf("expected the checksum of the ISO file to change")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					// Uploaded files can be removed afterward, i.e. by CI runners, without failing the plan
+					err := os.Remove(isoPath)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             configText2,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+const testAccVcfaContentLibraryItemNewVersion = `
+resource "vcfa_content_library_item" "iso" {
+  name               = "{{.Name}}"
+  description        = "{{.Name}}"
+  content_library_id = {{.ContentLibraryRef}}
+  file_paths         = ["{{.IsoPath}}"]
+}
+`
+
+// TestAccVcfaContentLibraryItemSourceUrl tests Content Library Items streamed from HTTP URLs, served by a local HTTP server
+// that requires an 'Authorization' header
+func TestAccVcfaContentLibraryItemSourceUrl(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	contentLibraryHcl, contentLibraryHclRef := getContentLibraryHcl(t, regionHclRef, "")
+
+	itemPaths := getTestingResourcesAbsolutePaths(t, contentLibraryItemTestingResourcePaths)
+	isoChecksum, err := fileSha256(itemPaths[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	ovaChecksum, err := fileSha256(itemPaths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/images/test.iso":
+			http.ServeFile(w, r, itemPaths[1])
+		case "/images/test_vapp_template.ova":
+			http.ServeFile(w, r, itemPaths[0])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var params = StringMap{
+		"Name":              t.Name(),
+		"ContentLibraryRef": fmt.Sprintf("%s.id", contentLibraryHclRef),
+		"IsoUrl":            server.URL + "/images/test.iso",
+		"OvaUrl":            server.URL + "/images/test_vapp_template.ova",
+		"IsoChecksum":       "sha256:" + isoChecksum,
+		"Tags":              "tm contentlibrary",
+	}
+	testParamsNotEmpty(t, params)
+
+	preRequisites := vCenterHcl + nsxManagerHcl + regionHcl + contentLibraryHcl
+
+	configText1 := templateFill(preRequisites+testAccVcfaContentLibraryItemSourceUrl, params)
+	params["FuncName"] = t.Name() + "-step2"
+	params["IsoChecksum"] = strings.Repeat("0", 64)
+	configText2 := templateFill(preRequisites+testAccVcfaContentLibraryItemSourceUrl, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	iso := "vcfa_content_library_item.iso"
+	ova := "vcfa_content_library_item.ova"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(iso, "item_type", "ISO"),
+					resource.TestCheckResourceAttr(iso, "version", "1"),
+					resource.TestCheckResourceAttr(iso, "file_checksums.test.iso", isoChecksum),
+					resource.TestCheckResourceAttr(ova, "item_type", "TEMPLATE"),
+					resource.TestCheckResourceAttr(ova, "version", "1"),
+					resource.TestCheckResourceAttr(ova, "file_checksums.test_vapp_template.ova", ovaChecksum),
+				),
+			},
+			{
+				// A wrong checksum makes the new version fail before the upload finishes
+				Config:      configText2,
+				ExpectError: regexp.MustCompile(`the SHA-256 checksum of 'test.iso' is`),
+			},
+		},
+	})
+}
+
+const testAccVcfaContentLibraryItemSourceUrl = `
+resource "vcfa_content_library_item" "iso" {
+  name               = "{{.Name}}-iso"
+  content_library_id = {{.ContentLibraryRef}}
+  source_url         = "{{.IsoUrl}}"
+  source_checksum    = "{{.IsoChecksum}}"
+  source_headers = {
+    "Authorization" = "Bearer test-token"
+  }
+}
+
+resource "vcfa_content_library_item" "ova" {
+  name               = "{{.Name}}-ova"
+  content_library_id = {{.ContentLibraryRef}}
+  source_url         = "{{.OvaUrl}}"
+  upload_parallelism = 2
+  source_headers = {
+    "Authorization" = "Bearer test-token"
+  }
+}
+`
+
+// TestAccVcfaContentLibraryItemDownload tests that the files of Content Library Items can be downloaded with
+// vcfa_content_library_item_download data source, and that files that are already downloaded are skipped
+func TestAccVcfaContentLibraryItemDownload(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	contentLibraryHcl, contentLibraryHclRef := getContentLibraryHcl(t, regionHclRef, "")
+
+	itemPaths := getTestingResourcesAbsolutePaths(t, contentLibraryItemTestingResourcePaths)
+	downloadPath := t.TempDir()
+	var params = StringMap{
+		"Name":              t.Name(),
+		"ContentLibraryRef": fmt.Sprintf("%s.id", contentLibraryHclRef),
+		"OvaPath":           itemPaths[0],
+		"IsoPath":           itemPaths[1],
+		"DownloadPath":      downloadPath,
+		"Tags":              "tm contentlibrary",
+	}
+	testParamsNotEmpty(t, params)
+
+	preRequisites := vCenterHcl + nsxManagerHcl + regionHcl + contentLibraryHcl
+
+	configText1 := templateFill(preRequisites+testAccVcfaContentLibraryItemDownload, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(preRequisites+testAccVcfaContentLibraryItemDownload, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	isoDownload := "data.vcfa_content_library_item_download.iso"
+	ovaDownload := "data.vcfa_content_library_item_download.ova"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(isoDownload, "file_paths.#", "1"),
+					resource.TestCheckResourceAttr(isoDownload, "file_checksums.%", "1"),
+					resource.TestCheckResourceAttr(isoDownload, "downloaded_files.#", "1"),
+					resource.TestCheckResourceAttr(isoDownload, "skipped_files.#", "0"),
+					func(s *terraform.State) error {
+						// The downloaded ISO must be the same as the uploaded one
+						uploaded := s.RootModule().Resources["vcfa_content_library_item.iso"].Primary.Attributes["file_checksums.test.iso"]
+						for k, v := range s.RootModule().Resources[isoDownload].Primary.Attributes {
+							if strings.HasPrefix(k, "file_checksums.") && k != "file_checksums.%" && v != uploaded {
+								return fmt.Errorf("expected downloaded checksum '%s' to be '%s'", v, uploaded)
+							}
+						}
+						return nil
+					},
+
+					// OVAs are stored as OVF descriptor plus disks, the descriptor must be the first file
+					resource.TestMatchResourceAttr(ovaDownload, "file_paths.#", regexp.MustCompile(`^[2-9]$`)),
+					resource.TestMatchResourceAttr(ovaDownload, "file_paths.0", regexp.MustCompile(`\.ovf$`)),
+					resource.TestCheckResourceAttr(ovaDownload, "skipped_files.#", "0"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(isoDownload, "downloaded_files.#", "0"),
+					resource.TestCheckResourceAttr(isoDownload, "skipped_files.#", "1"),
+					resource.TestCheckResourceAttr(ovaDownload, "downloaded_files.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaContentLibraryItemDownload = `
+resource "vcfa_content_library_item" "iso" {
+  name               = "{{.Name}}Iso"
+  content_library_id = {{.ContentLibraryRef}}
+  file_paths         = ["{{.IsoPath}}"]
+}
+
+resource "vcfa_content_library_item" "ova" {
+  name               = "{{.Name}}Ova"
+  content_library_id = {{.ContentLibraryRef}}
+  file_paths         = ["{{.OvaPath}}"]
+}
+
+data "vcfa_content_library_item_download" "iso" {
+  content_library_item_id = vcfa_content_library_item.iso.id
+  download_path           = "{{.DownloadPath}}/iso"
+}
+
+data "vcfa_content_library_item_download" "ova" {
+  content_library_item_id = vcfa_content_library_item.ova.id
+  download_path           = "{{.DownloadPath}}/ova"
+}
+`
+
+// TestAccVcfaContentLibraryItemMetadata tests the metadata of Content Library Items, and the lookups that filter by metadata
+func TestAccVcfaContentLibraryItemMetadata(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	contentLibraryHcl, contentLibraryHclRef := getContentLibraryHcl(t, regionHclRef, "")
+
+	var params = StringMap{
+		"Name":              t.Name(),
+		"ContentLibraryRef": fmt.Sprintf("%s.id", contentLibraryHclRef),
+		"IsoPath":           getTestingResourcesAbsolutePaths(t, contentLibraryItemTestingResourcePaths)[1],
+		"Tags":              "tm contentlibrary",
+	}
+	testParamsNotEmpty(t, params)
+
+	preRequisites := vCenterHcl + nsxManagerHcl + regionHcl + contentLibraryHcl
+
+	configText1 := templateFill(preRequisites+testAccVcfaContentLibraryItemMetadataStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(preRequisites+testAccVcfaContentLibraryItemMetadataStep2, params)
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(preRequisites+testAccVcfaContentLibraryItemMetadataStep3, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	debugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	cli := "vcfa_content_library_item.iso"
+	cachedId := &testCachedFieldValue{}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.cacheTestResourceFieldValue(cli, "id"),
+					resource.TestCheckResourceAttr(cli, "metadata.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(cli, "metadata.*", map[string]string{
+						"key":        "os_version",
+						"value":      "22.04",
+						"type":       "StringEntry",
+						"visibility": "READWRITE",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(cli, "metadata.*", map[string]string{
+						"key":   "build_id",
+						"value": "1234",
+						"type":  "NumberEntry",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(cli, "metadata.*", map[string]string{
+						"key":        "cve_scan_passed",
+						"value":      "true",
+						"type":       "BoolEntry",
+						"visibility": "READONLY",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(cli, "metadata.*", map[string]string{
+						"key":        "internal_owner",
+						"value":      "platform-team",
+						"visibility": "PRIVATE",
+					}),
+				),
+			},
+			{
+				// Updates a value, changes the visibility of an entry and removes another one
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.testCheckCachedResourceFieldValue(cli, "id"),
+					resource.TestCheckResourceAttr(cli, "metadata.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(cli, "metadata.*", map[string]string{
+						"key":   "build_id",
+						"value": "1235",
+						"type":  "NumberEntry",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(cli, "metadata.*", map[string]string{
+						"key":        "cve_scan_passed",
+						"value":      "false",
+						"visibility": "READWRITE",
+					}),
+				),
+			},
+			{
+				Config: configText3,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(cli, "id", "data.vcfa_content_library_item.iso_ds", "id"),
+					resource.TestCheckResourceAttr("data.vcfa_content_library_item.iso_ds", "metadata.#", "3"),
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.scanned", "items.#", "1"),
+					resource.TestCheckResourceAttrPair(cli, "id", "data.vcfa_content_library_items.scanned", "items.0.id"),
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.not_scanned", "items.#", "0"),
+				),
+			},
+			{
+				ResourceName:            cli,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           fmt.Sprintf("System%s%s%s%s", ImportSeparator, testConfig.Tm.ContentLibrary, ImportSeparator, params["Name"].(string)),
+				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "upload_progress_interval_seconds", "upload_journal_directory", "source_allow_unverified_ssl", "file_checksums.%", "file_checksums.test.iso", "%", "file_fingerprints.%", "file_fingerprints.test.iso"},
+			},
+		},
+	})
+}
+
+const testAccVcfaContentLibraryItemMetadataStep1 = `
+resource "vcfa_content_library_item" "iso" {
+  name               = "{{.Name}}"
+  description        = "{{.Name}}"
+  content_library_id = {{.ContentLibraryRef}}
+  file_paths         = ["{{.IsoPath}}"]
+
+  metadata {
+    key   = "os_version"
+    value = "22.04"
+  }
+  metadata {
+    key   = "build_id"
+    value = "1234"
+    type  = "NumberEntry"
+  }
+  metadata {
+    key        = "cve_scan_passed"
+    value      = "true"
+    type       = "BoolEntry"
+    visibility = "READONLY"
+  }
+  metadata {
+    key        = "internal_owner"
+    value      = "platform-team"
+    visibility = "PRIVATE"
+  }
+}
+`
+
+const testAccVcfaContentLibraryItemMetadataStep2 = `
+resource "vcfa_content_library_item" "iso" {
+  name               = "{{.Name}}"
+  description        = "{{.Name}}"
+  content_library_id = {{.ContentLibraryRef}}
+  file_paths         = ["{{.IsoPath}}"]
+
+  metadata {
+    key   = "os_version"
+    value = "22.04"
+  }
+  metadata {
+    key   = "build_id"
+    value = "1235"
+    type  = "NumberEntry"
+  }
+  metadata {
+    key   = "cve_scan_passed"
+    value = "false"
+    type  = "BoolEntry"
+  }
+}
+`
+
+const testAccVcfaContentLibraryItemMetadataStep3 = testAccVcfaContentLibraryItemMetadataStep2 + `
+data "vcfa_content_library_item" "iso_ds" {
+  name               = vcfa_content_library_item.iso.name
+  content_library_id = {{.ContentLibraryRef}}
+
+  metadata_filter {
+    key   = "build_id"
+    value = "1235"
+    type  = "NumberEntry"
+  }
+}
+
+data "vcfa_content_library_items" "scanned" {
+  content_library_id = {{.ContentLibraryRef}}
+  name_regex         = "^{{.Name}}$"
+
+  metadata_filter {
+    key   = "cve_scan_passed"
+    value = "false"
+    type  = "BoolEntry"
+  }
+
+  depends_on = [vcfa_content_library_item.iso]
+}
+
+data "vcfa_content_library_items" "not_scanned" {
+  content_library_id = {{.ContentLibraryRef}}
+  name_regex         = "^{{.Name}}$"
+
+  metadata_filter {
+    key   = "cve_scan_passed"
+    value = "true"
+    type  = "BoolEntry"
+  }
+
+  depends_on = [vcfa_content_library_item.iso]
+}
+`
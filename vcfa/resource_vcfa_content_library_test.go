@@ -38,6 +38,8 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 		"VsphereUrl":          strings.Split(testConfig.Tm.VcenterUrl, "://")[1],
 		"VsphereDatacenter":   testConfig.Tm.VcenterDatacenter,
 		"VsphereDatastore":    testConfig.Tm.VcenterDatastore,
+		"SyncTrigger":         "1",
+		"MetadataValue":       "1",
 		"Tags":                "tm contentlibrary",
 	}
 	testParamsNotEmpty(t, params)
@@ -54,6 +56,8 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 	configText1 := templateFill(preRequisites+testAccVcfaContentLibraryProviderStep1, params)
 	params["FuncName"] = t.Name() + "-step2"
 	params["Name"] = t.Name() + "Updated"
+	params["SyncTrigger"] = "2"
+	params["MetadataValue"] = "2"
 	configText2 := templateFill(preRequisites+testAccVcfaContentLibraryProviderStep1, params)
 	params["FuncName"] = t.Name() + "-step3"
 	configText3 := templateFill(preRequisites+testAccVcfaContentLibraryProviderStep3, params)
@@ -120,6 +124,18 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "all_projects_permission", ""),
 					resource.TestCheckResourceAttr(resourceName, "project_permissions.#", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metadata.*", map[string]string{
+						"key":        "team",
+						"value":      "platform",
+						"type":       "StringEntry",
+						"visibility": "READONLY",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metadata.*", map[string]string{
+						"key":   "revision",
+						"value": "1",
+						"type":  "NumberEntry",
+					}),
 
 					// Subscribed Content Library
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "name", t.Name()+"Subscribed"),
@@ -134,11 +150,17 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceNameSubscribed, "subscription_config.0.subscription_url", "vsphere_content_library.publisher_content_library", "publication.0.publish_url"),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.password", "password"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.sync_mode", "ON_DEMAND"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.download_content_lazily", "false"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.sync_on_create_wait", "true"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.sync_trigger", "1"),
 					resource.TestMatchResourceAttr(resourceNameSubscribed, "version_number", regexp.MustCompile("[0-9]")),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "is_project_scoped", "false"),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "all_projects_permission", ""),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "project_permissions.#", "0"),
-					resource.TestCheckResourceAttrSet(resourceNameSubscribed, "status"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "status", "READY"), // Waited for the first synchronization
+					resource.TestMatchResourceAttr(resourceNameSubscribed, "item_sync_status.#", regexp.MustCompile("[0-9]+")),
+					resource.TestCheckResourceAttr(resourceName, "item_sync_status.#", "0"), // Not subscribed
 				),
 			},
 			{
@@ -147,7 +169,15 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 					cachedId.testCheckCachedResourceFieldValue(resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "name", t.Name()+"Updated"),
 					resource.TestCheckResourceAttr(resourceName, "description", t.Name()+"Updated"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metadata.*", map[string]string{
+						"key":   "revision",
+						"value": "2",
+						"type":  "NumberEntry",
+					}),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "name", t.Name()+"UpdatedSubscribed"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.sync_trigger", "2"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "status", "READY"),
 				),
 			},
 			{
@@ -164,8 +194,10 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 						"%", // Does not have delete_recursive, delete_force
 						"delete_recursive",
 						"delete_force",
-						"subscription_config.0.%", // Does not have password
+						"subscription_config.0.%", // Does not have password, sync_on_create_wait, sync_trigger
 						"subscription_config.0.password",
+						"subscription_config.0.sync_on_create_wait",
+						"subscription_config.0.sync_trigger",
 					}),
 				),
 			},
@@ -239,6 +271,16 @@ resource "vcfa_content_library" "cl" {
   storage_class_ids = [
     data.vcfa_storage_class.sc.id
   ]
+  metadata {
+    key        = "team"
+    value      = "platform"
+    visibility = "READONLY"
+  }
+  metadata {
+    key   = "revision"
+    value = "{{.MetadataValue}}"
+    type  = "NumberEntry"
+  }
   delete_force = true
   delete_recursive = true
 }
@@ -250,8 +292,12 @@ resource "vcfa_content_library" "cl_subscribed" {
     data.vcfa_storage_class.sc.id
   ]
   subscription_config {
-    password         = local.vsphere_content_library_password
-    subscription_url = vsphere_content_library.publisher_content_library.publication[0].publish_url
+    password                = local.vsphere_content_library_password
+    subscription_url        = vsphere_content_library.publisher_content_library.publication[0].publish_url
+    sync_mode               = "ON_DEMAND"
+    download_content_lazily = false
+    sync_on_create_wait     = true
+    sync_trigger            = "{{.SyncTrigger}}"
   }
   delete_force = true
   delete_recursive = true
@@ -338,6 +384,7 @@ func TestAccVcfaContentLibraryTenant(t *testing.T) {
 	cl2 := "vcfa_content_library.cl2"
 	cl3 := "vcfa_content_library.cl3"
 	clSubscribed := "vcfa_content_library.cl_subscribed"
+	clSubscriberOfCl2 := "vcfa_content_library.cl_subscriber_of_cl2"
 	clAllProjectsScoped := "vcfa_content_library.cl_all_projects_scoped"
 	clProjectScoped := "vcfa_content_library.cl_project_scoped"
 
@@ -414,6 +461,15 @@ func TestAccVcfaContentLibraryTenant(t *testing.T) {
 					resource.TestCheckResourceAttr(cl2, "all_projects_permission", ""),
 					resource.TestCheckResourceAttr(cl2, "project_permissions.#", "0"),
 					resource.TestCheckResourceAttrSet(cl2, "status"),
+					resource.TestCheckResourceAttr(cl2, "publish_config.#", "1"),
+					resource.TestCheckResourceAttr(cl2, "publish_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr(cl2, "publish_config.0.password", "publisher-password"),
+					resource.TestCheckResourceAttrSet(cl2, "publish_config.0.publish_url"),
+					resource.TestCheckResourceAttr(cl1, "publish_config.#", "0"),
+
+					// Subscriber of a Content Library published by VCFA
+					resource.TestCheckResourceAttr(clSubscriberOfCl2, "is_subscribed", "true"),
+					resource.TestCheckResourceAttrPair(clSubscriberOfCl2, "subscription_config.0.subscription_url", cl2, "publish_config.0.publish_url"),
 
 					// Subscribed Content Library
 					resource.TestCheckResourceAttr(clSubscribed, "name", t.Name()+"Subscribed"),
@@ -443,6 +499,10 @@ func TestAccVcfaContentLibraryTenant(t *testing.T) {
 					cachedId.testCheckCachedResourceFieldValue(cl1, "id"),
 					resource.TestCheckResourceAttr(cl1, "name", t.Name()+"Updated"),
 					resource.TestCheckResourceAttr(cl2, "name", t.Name()+"2Updated"),
+					// Subscribers are reported once the subscribed Content Library exists
+					resource.TestCheckResourceAttr(cl2, "publish_config.0.subscribers.#", "1"),
+					resource.TestCheckResourceAttrPair(cl2, "publish_config.0.subscribers.0.content_library_id", clSubscriberOfCl2, "id"),
+					resource.TestCheckResourceAttrPair(cl2, "publish_config.0.subscribers.0.org_id", "vcfa_org.test", "id"),
 					resource.TestCheckResourceAttr(clSubscribed, "name", t.Name()+"UpdatedSubscribed"),
 				),
 			},
@@ -524,6 +584,8 @@ func TestAccVcfaContentLibraryTenant(t *testing.T) {
 						"delete_force",
 						"subscription_config.0.%", // Does not have password
 						"subscription_config.0.password",
+						"publish_config.0.%", // Does not have password
+						"publish_config.0.password",
 					}),
 					resourceFieldsEqual(cl3, "data.vcfa_content_library.cl_ds3", []string{
 						"%",
@@ -543,8 +605,10 @@ func TestAccVcfaContentLibraryTenant(t *testing.T) {
 						"%",
 						"delete_recursive",
 						"delete_force",
-						"subscription_config.0.%", // Does not have password
+						"subscription_config.0.%", // Does not have password, sync_on_create_wait, sync_trigger
 						"subscription_config.0.password",
+						"subscription_config.0.sync_on_create_wait",
+						"subscription_config.0.sync_trigger",
 					}),
 					resourceFieldsEqual(clAllProjectsScoped, "data.vcfa_content_library.cl_all_projects_scoped_dstenant", []string{
 						"%",
@@ -722,6 +786,25 @@ resource "vcfa_content_library" "cl2" {
   ]
   delete_force     = true # Should be ignored, otherwise it would fail
   delete_recursive = true
+
+  publish_config {
+    password = "publisher-password"
+  }
+}
+
+resource "vcfa_content_library" "cl_subscriber_of_cl2" {
+  provider    = vcfa
+  org_id      = vcfa_org_region_quota.test.org_id # Explicit dependency on Region Quota
+  name        = "{{.Name2}}Subscriber"
+  storage_class_ids = [
+    data.vcfa_storage_class.sc.id
+  ]
+  subscription_config {
+    password         = vcfa_content_library.cl2.publish_config[0].password
+    subscription_url = vcfa_content_library.cl2.publish_config[0].publish_url
+  }
+  delete_force     = true
+  delete_recursive = true
 }
 
 resource "vcfa_content_library" "cl_subscribed" {
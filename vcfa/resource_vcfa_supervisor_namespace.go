@@ -9,10 +9,12 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -222,6 +224,10 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 		ReadContext:   resourceVcfaSupervisorNamespaceRead,
 		UpdateContext: resourceVcfaSupervisorNamespaceUpdate,
 		DeleteContext: resourceVcfaSupervisorNamespaceDelete,
+		CustomizeDiff: customdiff.Sequence(
+			validateSupervisorNamespaceClassConfig,
+			dryRunSupervisorNamespace,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceVcfaSupervisorNamespaceImport,
 		},
@@ -276,6 +282,12 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Optional:    true,
 				Description: "Description",
 			},
+			"dry_run_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: fmt.Sprintf("When true, the %s is submitted in dry-run mode during plan, and the errors reported by the server are surfaced as plan errors", labelSupervisorNamespace),
+			},
 			"infra_policies": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -553,10 +565,88 @@ func resourceVcfaSupervisorNamespaceImport(ctx context.Context, d *schema.Resour
 	}
 
 	d.SetId(buildResourceId(projectName, name))
+	dSet(d, "dry_run_validation", false)
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// getSupervisorNamespaceArguments returns the arguments of the Supervisor Namespace that are sent to
+// VCFA, split into the ones whose change replaces the Supervisor Namespace and the ones that are sent
+// with an update. They are read from the schema, so that new arguments are never left out. Arguments
+// that only change the behaviour of the provider, like dry_run_validation, are not included.
+func getSupervisorNamespaceArguments() (forceNew []string, updatable []string) {
+	for name, s := range resourceVcfaSupervisorNamespace().Schema {
+		if (!s.Required && !s.Optional) || name == "dry_run_validation" {
+			continue
+		}
+		if s.ForceNew {
+			forceNew = append(forceNew, name)
+		} else {
+			updatable = append(updatable, name)
+		}
+	}
+	sort.Strings(forceNew)
+	sort.Strings(updatable)
+	return forceNew, updatable
+}
+
+// dryRunSupervisorNamespace submits the planned Supervisor Namespace in dry-run mode when
+// dry_run_validation is set, so that the admission errors of the server (e.g. invalid VPC, SEG or
+// shared subnet combinations) are reported during plan instead of apply
+func dryRunSupervisorNamespace(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("dry_run_validation").(bool) {
+		return nil
+	}
+
+	forceNewFields, updatableFields := getSupervisorNamespaceArguments()
+	for _, field := range append(forceNewFields, updatableFields...) {
+		if !d.NewValueKnown(field) {
+			log.Printf("[DEBUG] skipping dry-run validation of %s: %s is not known yet", labelSupervisorNamespace, field)
+			return nil
+		}
+	}
+
+	tmClient := meta.(ClientContainer).tmClient
+	projectName := d.Get("project_name").(string)
+
+	if d.Id() == "" || d.HasChanges(forceNewFields...) {
+		supervisorNamespace := supervisorNamespaceFromResourceData(d, projectName, d.Get("name_prefix").(string), "")
+		if err := submitSupervisorNamespaceDryRun(tmClient, projectName, "", supervisorNamespace); err != nil {
+			return fmt.Errorf("dry-run validation failed for %s: %s", labelSupervisorNamespace, err)
+		}
+		return nil
+	}
+
+	if !d.HasChanges(updatableFields...) {
+		return nil
+	}
+	_, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return fmt.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespace, d.Id(), err)
+	}
+	supervisorNamespace := supervisorNamespaceFromResourceData(d, projectName, "", name)
+	if err := submitSupervisorNamespaceDryRun(tmClient, projectName, name, supervisorNamespace); err != nil {
+		return fmt.Errorf("dry-run validation failed for %s %s: %s", labelSupervisorNamespace, name, err)
+	}
+	return nil
+}
+
+// submitSupervisorNamespaceDryRun creates (when the name is empty) or updates the given Supervisor
+// Namespace with `dryRun=All`, so that it is validated by the server without being persisted
+func submitSupervisorNamespaceDryRun(tmClient *VCDClient, projectName string, supervisorNamespaceName string, supervisorNamespace ccitypes.SupervisorNamespace) error {
+	var supervisorNamespaceOut ccitypes.SupervisorNamespace
+	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		return fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)
+	}
+	params := url.Values{"dryRun": []string{"All"}}
+
+	if supervisorNamespaceName == "" {
+		return tmClient.VCDClient.Client.PostEntity(supervisorNamespaceURL, params, &supervisorNamespace, &supervisorNamespaceOut, nil)
+	}
+	return tmClient.VCDClient.Client.PutEntity(supervisorNamespaceURL, params, &supervisorNamespace, &supervisorNamespaceOut, nil)
+}
+
 func createSupervisorNamespace(tmClient *VCDClient, projectName string, supervisorNamespace ccitypes.SupervisorNamespace) (ccitypes.SupervisorNamespace, error) {
 	var supervisorNamespaceOut ccitypes.SupervisorNamespace
 	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, "")
@@ -625,7 +715,14 @@ func parseResourceId(id string) (string, string, error) {
 	return idParts[0], idParts[1], nil
 }
 
-func supervisorNamespaceFromResourceData(d *schema.ResourceData, projectName, namePrefix, name string) ccitypes.SupervisorNamespace {
+// resourceDataGetter is implemented by both schema.ResourceData and schema.ResourceDiff, so that the
+// Supervisor Namespace payload can be built during plan as well as during apply
+type resourceDataGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+func supervisorNamespaceFromResourceData(d resourceDataGetter, projectName, namePrefix, name string) ccitypes.SupervisorNamespace {
 	objectMeta := v1.ObjectMeta{Namespace: projectName}
 	if name != "" {
 		objectMeta.Name = name
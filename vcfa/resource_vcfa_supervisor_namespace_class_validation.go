@@ -0,0 +1,289 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	labelSupervisorNamespaceClass = "Supervisor Namespace Class"
+
+	supervisorNamespaceClassesURL = "/apis/" + ccitypes.SupervisorNamespaceAPI + "/" + ccitypes.SupervisorNamespaceVersion + "/supervisornamespaceclasses"
+)
+
+// supervisorNamespaceClass is the class a Supervisor Namespace is created from. Its configuration
+// lists the storage classes, VM classes, zones and content sources that Supervisor Namespaces of the
+// class can use, and the maximum limits they can set.
+type supervisorNamespaceClass struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec supervisorNamespaceClassSpec `json:"spec,omitempty"`
+}
+
+type supervisorNamespaceClassSpec struct {
+	Description string                                               `json:"description,omitempty"`
+	Config      ccitypes.SupervisorNamespaceSpecClassConfigOverrides `json:"config,omitempty"`
+}
+
+// validateSupervisorNamespaceClassConfig validates the class configuration overrides against the
+// Supervisor Namespace Class and the Region during plan, so that mistakes don't surface as API errors
+// after the Supervisor Namespace has been submitted.
+// Every error starts with the name of the attribute it refers to, and carries its path when possible
+// (see joinClassConfigErrors).
+func validateSupervisorNamespaceClassConfig(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	overrides := []string{
+		"class_name",
+		"content_sources_class_config_overrides",
+		"storage_classes_class_config_overrides",
+		"storage_classes_initial_class_config_overrides",
+		"vm_classes_class_config_overrides",
+		"zones_class_config_overrides",
+		"zones_initial_class_config_overrides",
+	}
+	changed := d.Id() == ""
+	for _, attribute := range overrides {
+		if !d.NewValueKnown(attribute) {
+			log.Printf("[DEBUG] skipping validation of %s class configuration: %s is not known yet", labelSupervisorNamespace, attribute)
+			return nil
+		}
+		changed = changed || d.HasChange(attribute)
+	}
+	if !changed || !d.NewValueKnown("region_name") {
+		return nil
+	}
+
+	tmClient := meta.(ClientContainer).tmClient
+	className := d.Get("class_name").(string)
+	class, err := readSupervisorNamespaceClass(tmClient, className)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return cty.GetAttrPath("class_name").NewErrorf("class_name: %s %s does not exist", labelSupervisorNamespaceClass, className)
+		}
+		log.Printf("[DEBUG] skipping validation of %s class configuration: %s", labelSupervisorNamespace, err)
+		return nil
+	}
+
+	// The Region lookup is only available to some roles, so its checks are skipped when it fails
+	regionName := d.Get("region_name").(string)
+	region, err := tmClient.GetRegionByName(regionName)
+	if err != nil {
+		log.Printf("[DEBUG] skipping validation of %s class configuration against %s %s: %s", labelSupervisorNamespace, labelVcfaRegion, regionName, err)
+		region = nil
+	}
+
+	// The overrides are built the same way as the payload sent on create and update
+	supervisorNamespace := supervisorNamespaceFromResourceData(d, "", "", "")
+	spec := supervisorNamespace.Spec.ClassConfigOverrides
+
+	storageClassesAttribute := overrideAttributeName(d, "storage_classes")
+	zonesAttribute := overrideAttributeName(d, "zones")
+
+	var errs []error
+	errs = append(errs, validateStorageClassOverrides(storageClassesAttribute, spec.StorageClasses, class, region)...)
+	errs = append(errs, validateVmClassOverrides(spec.VmClasses, class, region)...)
+	errs = append(errs, validateZoneOverrides(zonesAttribute, spec.Zones, class, region)...)
+	errs = append(errs, validateContentSourceOverrides(tmClient, spec.ContentSources, class)...)
+
+	return joinClassConfigErrors(errs)
+}
+
+// joinClassConfigErrors joins the given validation errors into the single error that CustomizeDiff can return.
+// Every error is a cty.PathError, so that Terraform shows the attribute it refers to. As only one path can be
+// returned, the path is kept when all the errors refer to the same attribute, which is the usual case. Otherwise,
+// the attribute names at the start of the messages are the only reference to them.
+func joinClassConfigErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	var attributePath cty.Path
+	for i, err := range errs {
+		var pathErr cty.PathError
+		if !errors.As(err, &pathErr) || (i > 0 && !pathErr.Path.Equals(attributePath)) {
+			return errors.Join(errs...)
+		}
+		attributePath = pathErr.Path
+	}
+	return attributePath.NewError(errors.Join(errs...))
+}
+
+// overrideAttributeName returns which of the `<kind>_class_config_overrides` or the deprecated
+// `<kind>_initial_class_config_overrides` attributes is used
+func overrideAttributeName(d *schema.ResourceDiff, kind string) string {
+	attribute := kind + "_class_config_overrides"
+	if _, ok := d.GetOk(attribute); !ok {
+		return kind + "_initial_class_config_overrides"
+	}
+	return attribute
+}
+
+func validateStorageClassOverrides(attribute string, overrides []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, class supervisorNamespaceClass, region *govcd.Region) []error {
+	classStorageClasses := make(map[string]ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, len(class.Spec.Config.StorageClasses))
+	for _, sc := range class.Spec.Config.StorageClasses {
+		classStorageClasses[sc.Name] = sc
+	}
+
+	attributePath := cty.GetAttrPath(attribute)
+	var errs []error
+	for _, override := range overrides {
+		classStorageClass, ok := classStorageClasses[override.Name]
+		if !ok {
+			errs = append(errs, attributePath.NewErrorf("%s: storage class %s is not part of %s %s", attribute, override.Name, labelSupervisorNamespaceClass, class.Name))
+			continue
+		}
+		if err := validateQuantityWithin(override.Limit, classStorageClass.Limit); err != nil {
+			errs = append(errs, attributePath.NewErrorf("%s: limit of storage class %s: %s", attribute, override.Name, err))
+		}
+		if region != nil {
+			if _, err := region.GetStorageClassByName(override.Name); err != nil && govcd.ContainsNotFound(err) {
+				errs = append(errs, attributePath.NewErrorf("%s: storage class %s does not exist in %s %s", attribute, override.Name, labelVcfaRegion, region.Region.Name))
+			}
+		}
+	}
+	return errs
+}
+
+func validateVmClassOverrides(overrides []ccitypes.SupervisorNamespaceSpecClassConfigOverridesVmClass, class supervisorNamespaceClass, region *govcd.Region) []error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	classVmClasses := make(map[string]bool, len(class.Spec.Config.VmClasses))
+	for _, vmClass := range class.Spec.Config.VmClasses {
+		classVmClasses[vmClass.Name] = true
+	}
+
+	var regionVmClasses map[string]bool
+	if region != nil {
+		vmClasses, err := region.GetAllVmClasses(nil)
+		if err != nil {
+			log.Printf("[DEBUG] skipping validation of VM classes against %s %s: %s", labelVcfaRegion, region.Region.Name, err)
+		} else {
+			regionVmClasses = make(map[string]bool, len(vmClasses))
+			for _, vmClass := range vmClasses {
+				regionVmClasses[vmClass.Name] = true
+			}
+		}
+	}
+
+	attribute := "vm_classes_class_config_overrides"
+	attributePath := cty.GetAttrPath(attribute)
+	var errs []error
+	for _, override := range overrides {
+		if !classVmClasses[override.Name] {
+			errs = append(errs, attributePath.NewErrorf("%s: VM class %s is not part of %s %s", attribute, override.Name, labelSupervisorNamespaceClass, class.Name))
+			continue
+		}
+		if regionVmClasses != nil && !regionVmClasses[override.Name] {
+			errs = append(errs, attributePath.NewErrorf("%s: VM class %s does not exist in %s %s", attribute, override.Name, labelVcfaRegion, region.Region.Name))
+		}
+	}
+	return errs
+}
+
+func validateZoneOverrides(attribute string, overrides []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone, class supervisorNamespaceClass, region *govcd.Region) []error {
+	classZones := make(map[string]ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone, len(class.Spec.Config.Zones))
+	for _, zone := range class.Spec.Config.Zones {
+		classZones[zone.Name] = zone
+	}
+
+	attributePath := cty.GetAttrPath(attribute)
+	var errs []error
+	for _, override := range overrides {
+		classZone, ok := classZones[override.Name]
+		if !ok {
+			errs = append(errs, attributePath.NewErrorf("%s: zone %s is not part of %s %s", attribute, override.Name, labelSupervisorNamespaceClass, class.Name))
+			continue
+		}
+
+		limits := []struct {
+			field, value, maximum string
+		}{
+			{"cpu_limit", override.CpuLimit, classZone.CpuLimit},
+			{"cpu_reservation", override.CpuReservation, override.CpuLimit},
+			{"memory_limit", override.MemoryLimit, classZone.MemoryLimit},
+			{"memory_reservation", override.MemoryReservation, override.MemoryLimit},
+		}
+		for _, l := range limits {
+			if err := validateQuantityWithin(l.value, l.maximum); err != nil {
+				errs = append(errs, attributePath.NewErrorf("%s: %s of zone %s: %s", attribute, l.field, override.Name, err))
+			}
+		}
+
+		if region != nil {
+			if _, err := region.GetZoneByName(override.Name); err != nil && govcd.ContainsNotFound(err) {
+				errs = append(errs, attributePath.NewErrorf("%s: zone %s does not exist in %s %s", attribute, override.Name, labelVcfaRegion, region.Region.Name))
+			}
+		}
+	}
+	return errs
+}
+
+func validateContentSourceOverrides(tmClient *VCDClient, overrides []ccitypes.SupervisorNamespaceSpecClassConfigOverridesContentSources, class supervisorNamespaceClass) []error {
+	classContentSources := make(map[string]bool, len(class.Spec.Config.ContentSources))
+	for _, contentSource := range class.Spec.Config.ContentSources {
+		classContentSources[contentSource.Name] = true
+	}
+
+	attribute := "content_sources_class_config_overrides"
+	attributePath := cty.GetAttrPath(attribute)
+	var errs []error
+	for _, override := range overrides {
+		if !classContentSources[override.Name] {
+			errs = append(errs, attributePath.NewErrorf("%s: content library %s is not part of %s %s", attribute, override.Name, labelSupervisorNamespaceClass, class.Name))
+			continue
+		}
+		if _, err := tmClient.GetContentLibraryByName(override.Name, nil); err != nil && govcd.ContainsNotFound(err) {
+			errs = append(errs, attributePath.NewErrorf("%s: content library %s does not exist", attribute, override.Name))
+		}
+	}
+	return errs
+}
+
+// validateQuantityWithin checks that the given quantity is valid and, when a maximum is set, that it
+// does not exceed it
+func validateQuantityWithin(value, maximum string) error {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return fmt.Errorf("invalid value %q: %s", value, err)
+	}
+	if maximum == "" {
+		return nil
+	}
+	maximumQuantity, err := resource.ParseQuantity(maximum)
+	if err != nil {
+		log.Printf("[DEBUG] ignoring invalid maximum %q: %s", maximum, err)
+		return nil
+	}
+	if quantity.Cmp(maximumQuantity) > 0 {
+		return fmt.Errorf("%s exceeds the maximum of %s", value, maximum)
+	}
+	return nil
+}
+
+func readSupervisorNamespaceClass(tmClient *VCDClient, className string) (supervisorNamespaceClass, error) {
+	var class supervisorNamespaceClass
+	classURL, err := tmClient.VCDClient.Client.GetEntityUrl(supervisorNamespaceClassesURL + "/" + url.PathEscape(className))
+	if err != nil {
+		return class, fmt.Errorf("error building %s URL: %s", labelSupervisorNamespaceClass, err)
+	}
+	if err := tmClient.VCDClient.Client.GetEntity(classURL, nil, &class, nil); err != nil {
+		return class, fmt.Errorf("error reading %s %s: %w", labelSupervisorNamespaceClass, className, err)
+	}
+	return class, nil
+}
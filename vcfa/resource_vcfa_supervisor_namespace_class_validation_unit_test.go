@@ -0,0 +1,257 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testSupervisorNamespaceClass returns a Supervisor Namespace Class with a storage class and a zone, to validate
+// the overrides against
+func testSupervisorNamespaceClass() supervisorNamespaceClass {
+	return supervisorNamespaceClass{
+		ObjectMeta: v1.ObjectMeta{Name: "small"},
+		Spec: supervisorNamespaceClassSpec{
+			Config: ccitypes.SupervisorNamespaceSpecClassConfigOverrides{
+				StorageClasses: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{
+					{Name: "vsan-default", Limit: "100Gi"},
+					{Name: "unlimited"},
+				},
+				Zones: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone{
+					{Name: "zone1", CpuLimit: "10G", MemoryLimit: "32Gi"},
+				},
+			},
+		},
+	}
+}
+
+// checkValidationErrors checks that the given errors contain, in order, the expected messages, and that
+// they point to the given attribute
+func checkValidationErrors(t *testing.T, errs []error, attribute string, expected []string) {
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, err := range errs {
+		if !strings.Contains(err.Error(), expected[i]) {
+			t.Errorf("expected error %d to contain %q, got %q", i, expected[i], err)
+		}
+		var pathErr cty.PathError
+		if !errors.As(err, &pathErr) || !pathErr.Path.Equals(cty.GetAttrPath(attribute)) {
+			t.Errorf("expected error %d to point to %s, got %#v", i, attribute, err)
+		}
+	}
+}
+
+func TestValidateQuantityWithin(t *testing.T) {
+	type testCase struct {
+		name          string
+		value         string
+		maximum       string
+		expectedError string
+	}
+
+	testCases := []testCase{
+		{name: "BelowMaximum", value: "10Gi", maximum: "32Gi"},
+		{name: "EqualToMaximum", value: "32Gi", maximum: "32Gi"},
+		{name: "EqualToMaximumInOtherUnit", value: "1024Mi", maximum: "1Gi"},
+		{name: "NoMaximum", value: "1Ti"},
+		{name: "InvalidMaximumIsIgnored", value: "1Ti", maximum: "lots"},
+		{name: "ExceedsMaximum", value: "33Gi", maximum: "32Gi", expectedError: "33Gi exceeds the maximum of 32Gi"},
+		{name: "ExceedsMaximumInOtherUnit", value: "2000M", maximum: "1G", expectedError: "2000M exceeds the maximum of 1G"},
+		{name: "InvalidQuantity", value: "10 gigabytes", maximum: "32Gi", expectedError: `invalid value "10 gigabytes"`},
+		{name: "EmptyQuantity", value: "", expectedError: `invalid value ""`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateQuantityWithin(tc.value, tc.maximum)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("expected error containing %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestValidateStorageClassOverrides(t *testing.T) {
+	type testCase struct {
+		name           string
+		overrides      []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass
+		expectedErrors []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "Valid",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{
+				{Name: "vsan-default", Limit: "100Gi"},
+				{Name: "unlimited", Limit: "10Ti"},
+			},
+		},
+		{
+			name: "ExceedsMaximum",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{
+				{Name: "vsan-default", Limit: "101Gi"},
+			},
+			expectedErrors: []string{"storage_classes_class_config_overrides: limit of storage class vsan-default: 101Gi exceeds the maximum of 100Gi"},
+		},
+		{
+			name: "NotPartOfClass",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{
+				{Name: "gold", Limit: "1Gi"},
+				{Name: "vsan-default", Limit: "1Gi"},
+			},
+			expectedErrors: []string{"storage_classes_class_config_overrides: storage class gold is not part of Supervisor Namespace Class small"},
+		},
+		{
+			name: "InvalidQuantity",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{
+				{Name: "unlimited", Limit: "big"},
+			},
+			expectedErrors: []string{`storage_classes_class_config_overrides: limit of storage class unlimited: invalid value "big"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateStorageClassOverrides("storage_classes_class_config_overrides", tc.overrides, testSupervisorNamespaceClass(), nil)
+			checkValidationErrors(t, errs, "storage_classes_class_config_overrides", tc.expectedErrors)
+		})
+	}
+}
+
+func TestValidateZoneOverrides(t *testing.T) {
+	type testCase struct {
+		name           string
+		overrides      []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone
+		expectedErrors []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "Valid",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone{
+				{Name: "zone1", CpuLimit: "10G", CpuReservation: "1G", MemoryLimit: "32Gi", MemoryReservation: "32Gi"},
+			},
+		},
+		{
+			name: "ExceedsMaximum",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone{
+				{Name: "zone1", CpuLimit: "11G", CpuReservation: "1G", MemoryLimit: "64Gi", MemoryReservation: "1Gi"},
+			},
+			expectedErrors: []string{
+				"zones_class_config_overrides: cpu_limit of zone zone1: 11G exceeds the maximum of 10G",
+				"zones_class_config_overrides: memory_limit of zone zone1: 64Gi exceeds the maximum of 32Gi",
+			},
+		},
+		{
+			name: "ReservationExceedsLimit",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone{
+				{Name: "zone1", CpuLimit: "1G", CpuReservation: "2G", MemoryLimit: "1Gi", MemoryReservation: "2Gi"},
+			},
+			expectedErrors: []string{
+				"zones_class_config_overrides: cpu_reservation of zone zone1: 2G exceeds the maximum of 1G",
+				"zones_class_config_overrides: memory_reservation of zone zone1: 2Gi exceeds the maximum of 1Gi",
+			},
+		},
+		{
+			name: "NotPartOfClass",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone{
+				{Name: "zone2", CpuLimit: "1G", CpuReservation: "1G", MemoryLimit: "1Gi", MemoryReservation: "1Gi"},
+			},
+			expectedErrors: []string{"zones_class_config_overrides: zone zone2 is not part of Supervisor Namespace Class small"},
+		},
+		{
+			name: "InvalidQuantity",
+			overrides: []ccitypes.SupervisorNamespaceSpecClassConfigOverridesZone{
+				{Name: "zone1", CpuLimit: "1G", CpuReservation: "1G", MemoryLimit: "1 GB", MemoryReservation: "1Gi"},
+			},
+			expectedErrors: []string{`zones_class_config_overrides: memory_limit of zone zone1: invalid value "1 GB"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := validateZoneOverrides("zones_class_config_overrides", tc.overrides, testSupervisorNamespaceClass(), nil)
+			checkValidationErrors(t, errs, "zones_class_config_overrides", tc.expectedErrors)
+		})
+	}
+}
+
+func TestJoinClassConfigErrors(t *testing.T) {
+	storageClassesPath := cty.GetAttrPath("storage_classes_class_config_overrides")
+	zonesPath := cty.GetAttrPath("zones_class_config_overrides")
+
+	type testCase struct {
+		name         string
+		errs         []error
+		expectedPath cty.Path
+	}
+
+	testCases := []testCase{
+		{
+			name:         "SingleError",
+			errs:         []error{zonesPath.NewErrorf("zones_class_config_overrides: zone zone2 is not part of it")},
+			expectedPath: zonesPath,
+		},
+		{
+			name: "SameAttribute",
+			errs: []error{
+				zonesPath.NewErrorf("zones_class_config_overrides: cpu_limit of zone zone1: 11G exceeds the maximum of 10G"),
+				zonesPath.NewErrorf("zones_class_config_overrides: zone zone2 is not part of it"),
+			},
+			expectedPath: zonesPath,
+		},
+		{
+			name: "DifferentAttributes",
+			errs: []error{
+				zonesPath.NewErrorf("zones_class_config_overrides: zone zone2 is not part of it"),
+				storageClassesPath.NewErrorf("storage_classes_class_config_overrides: storage class gold is not part of it"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := joinClassConfigErrors(tc.errs)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			for _, e := range tc.errs {
+				if !strings.Contains(err.Error(), e.Error()) {
+					t.Errorf("expected error to contain %q, got %q", e, err)
+				}
+			}
+
+			pathErr, isPathErr := err.(cty.PathError)
+			if tc.expectedPath == nil {
+				if isPathErr {
+					t.Errorf("expected no attribute path, got %#v", pathErr.Path)
+				}
+				return
+			}
+			if !isPathErr || !pathErr.Path.Equals(tc.expectedPath) {
+				t.Errorf("expected attribute path %#v, got %#v", tc.expectedPath, err)
+			}
+		})
+	}
+
+	if err := joinClassConfigErrors(nil); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+}
@@ -106,6 +106,7 @@ func TestAccVcfaSupervisorNamespaceExternal(t *testing.T) {
 				Config: configText2,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "description", params["DescriptionUpdated"].(string)),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "dry_run_validation", "true"),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "region_name", params["RegionName"].(string)),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "vpc_name", params["VpcName"].(string)),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "content_sources_class_config_overrides.#", "1"),
@@ -133,7 +134,7 @@ func TestAccVcfaSupervisorNamespaceExternal(t *testing.T) {
 				ResourceName:            "vcfa_supervisor_namespace.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"name_prefix"},
+				ImportStateVerifyIgnore: []string{"name_prefix", "dry_run_validation"},
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					return params["ProjectName"].(string) + ImportSeparator + cachedNamespaceName.FieldValue(), nil
 				},
@@ -189,6 +190,7 @@ resource "vcfa_supervisor_namespace" "test" {
   project_name        = "{{.ProjectName}}"
   class_name          = "small"
   description         = "{{.DescriptionUpdated}}"
+  dry_run_validation  = true
   infra_policy_names  = [ "{{.InfraPolicyName}}" ]
   region_name         = "{{.RegionName}}"
   shared_subnet_names = [ "{{.SharedSubnetName}}" ]
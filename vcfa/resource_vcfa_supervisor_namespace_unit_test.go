@@ -0,0 +1,37 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGetSupervisorNamespaceArguments(t *testing.T) {
+	forceNew, updatable := getSupervisorNamespaceArguments()
+
+	expectedForceNew := []string{"class_name", "name_prefix", "project_name", "region_name", "vpc_name"}
+	if !slices.Equal(forceNew, expectedForceNew) {
+		t.Errorf("expected arguments that replace the %s %v, got %v", labelSupervisorNamespace, expectedForceNew, forceNew)
+	}
+
+	expectedUpdatable := []string{
+		"content_sources_class_config_overrides",
+		"description",
+		"infra_policy_names",
+		"seg_name",
+		"shared_subnet_names",
+		"storage_classes_class_config_overrides",
+		"storage_classes_initial_class_config_overrides",
+		"vm_classes_class_config_overrides",
+		"zones_class_config_overrides",
+		"zones_initial_class_config_overrides",
+	}
+	if !slices.Equal(updatable, expectedUpdatable) {
+		t.Errorf("expected updatable arguments %v, got %v", expectedUpdatable, updatable)
+	}
+}
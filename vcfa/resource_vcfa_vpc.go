@@ -0,0 +1,231 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaVpc = "VPC"
+
+func resourceVcfaVpc() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaVpcCreate,
+		ReadContext:   resourceVcfaVpcRead,
+		UpdateContext: resourceVcfaVpcUpdate,
+		DeleteContext: resourceVcfaVpcDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaVpcImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Name of %s", labelVcfaVpc),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Description of %s", labelVcfaVpc),
+			},
+			"org_regional_networking_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the %s this %s belongs to", labelVcfaRegionalNetworkingSetting, labelVcfaVpc),
+			},
+			"org_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Parent %s ID of %s", labelVcfaOrg, labelVcfaVpc),
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Parent %s ID of %s", labelVcfaRegion, labelVcfaVpc),
+			},
+			"connectivity_profile_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("Name of the VPC connectivity profile of %s. Defaults to the default VPC connectivity profile of the %s",
+					labelVcfaVpc, labelVcfaRegionalNetworkingSetting),
+			},
+			"private_cidrs": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: fmt.Sprintf("Private CIDR blocks of %s, used by its private and isolated subnets", labelVcfaVpc),
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+				},
+			},
+			"public_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("Public CIDR blocks of %s, used by its public subnets. They must be part of the external CIDR blocks of the connectivity profile",
+					labelVcfaVpc),
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+				},
+			},
+			"backing_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID for the matching VPC in NSX",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Status of %s", labelVcfaVpc),
+			},
+		},
+	}
+}
+
+func resourceVcfaVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("org_regional_networking_id").(string))
+	defer unlock()
+
+	c := crudConfig[*tmVpc, tmVpcConfig]{
+		entityLabel:      labelVcfaVpc,
+		getTypeFunc:      getVpcType,
+		stateStoreFunc:   setVpcData,
+		createFunc:       tmClient.createTmVpc,
+		getEntityFunc:    tmClient.getTmVpcById,
+		resourceReadFunc: resourceVcfaVpcRead,
+	}
+	return createResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("org_regional_networking_id").(string))
+	defer unlock()
+
+	c := crudConfig[*tmVpc, tmVpcConfig]{
+		entityLabel:      labelVcfaVpc,
+		getTypeFunc:      getVpcType,
+		getEntityFunc:    tmClient.getTmVpcById,
+		resourceReadFunc: resourceVcfaVpcRead,
+	}
+
+	return updateResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	c := crudConfig[*tmVpc, tmVpcConfig]{
+		entityLabel:    labelVcfaVpc,
+		getEntityFunc:  tmClient.getTmVpcById,
+		stateStoreFunc: setVpcData,
+	}
+	return readResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("org_regional_networking_id").(string))
+	defer unlock()
+
+	c := crudConfig[*tmVpc, tmVpcConfig]{
+		entityLabel:   labelVcfaVpc,
+		getEntityFunc: tmClient.getTmVpcById,
+	}
+
+	return deleteResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	id := strings.Split(d.Id(), ImportSeparator)
+	if len(id) != 3 {
+		return nil, fmt.Errorf("ID syntax should be <%s name>%s<%s name>%s<%s name>", labelVcfaOrg, ImportSeparator,
+			labelVcfaRegionalNetworkingSetting, ImportSeparator, labelVcfaVpc)
+	}
+
+	rns, err := getRegionalNetworkingSettingByOrgName(tmClient, id[0], id[1])
+	if err != nil {
+		return nil, err
+	}
+
+	vpc, err := tmClient.getTmVpcByNameAndRegionalNetworkingId(id[2], rns.TmRegionalNetworkingSetting.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s' within %s '%s': %s", labelVcfaVpc, id[2], labelVcfaRegionalNetworkingSetting, id[1], err)
+	}
+
+	dSet(d, "org_regional_networking_id", rns.TmRegionalNetworkingSetting.ID)
+	d.SetId(vpc.TmVpc.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getRegionalNetworkingSettingByOrgName retrieves the Regional Networking Setting with the given
+// name from the Organization with the given name
+func getRegionalNetworkingSettingByOrgName(tmClient *VCDClient, orgName, name string) (*govcd.TmRegionalNetworkingSetting, error) {
+	org, err := tmClient.GetTmOrgByName(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgName, err)
+	}
+
+	rns, err := tmClient.GetTmRegionalNetworkingSettingByNameAndOrgId(name, org.TmOrg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s' within %s '%s': %s",
+			labelVcfaRegionalNetworkingSetting, name, labelVcfaOrg, orgName, err)
+	}
+	return rns, nil
+}
+
+func getVpcType(_ *VCDClient, d *schema.ResourceData) (*tmVpcConfig, error) {
+	t := &tmVpcConfig{
+		Name:                         d.Get("name").(string),
+		Description:                  d.Get("description").(string),
+		RegionalNetworkingSettingRef: types.OpenApiReference{ID: d.Get("org_regional_networking_id").(string)},
+		VpcConnectivityProfileName:   d.Get("connectivity_profile_name").(string),
+		PrivateCidrBlocks:            convertSchemaSetToSliceOfStrings(d.Get("private_cidrs").(*schema.Set)),
+		PublicCidrBlocks:             convertSchemaSetToSliceOfStrings(d.Get("public_cidrs").(*schema.Set)),
+	}
+
+	return t, nil
+}
+
+func setVpcData(_ *VCDClient, d *schema.ResourceData, v *tmVpc) error {
+	if v == nil || v.TmVpc == nil {
+		return fmt.Errorf("nil %s received", labelVcfaVpc)
+	}
+
+	d.SetId(v.TmVpc.ID)
+	dSet(d, "name", v.TmVpc.Name)
+	dSet(d, "description", v.TmVpc.Description)
+	dSet(d, "org_regional_networking_id", v.TmVpc.RegionalNetworkingSettingRef.ID)
+	dSet(d, "org_id", v.TmVpc.OrgRef.ID)
+	dSet(d, "region_id", v.TmVpc.RegionRef.ID)
+	dSet(d, "connectivity_profile_name", v.TmVpc.VpcConnectivityProfileName)
+	dSet(d, "backing_id", v.TmVpc.BackingId)
+	dSet(d, "status", v.TmVpc.Status)
+
+	err := d.Set("private_cidrs", v.TmVpc.PrivateCidrBlocks)
+	if err != nil {
+		return fmt.Errorf("error storing 'private_cidrs': %s", err)
+	}
+	err = d.Set("public_cidrs", v.TmVpc.PublicCidrBlocks)
+	if err != nil {
+		return fmt.Errorf("error storing 'public_cidrs': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaVpcSubnet = "VPC Subnet"
+
+// Access modes of VPC Subnets
+var vpcSubnetAccessModes = []string{"PUBLIC", "PRIVATE", "ISOLATED"}
+
+// DHCP modes of VPC Subnets
+var vpcSubnetDhcpModes = []string{"DHCP_SERVER", "DHCP_RELAY", "DHCP_DEACTIVATED"}
+
+func resourceVcfaVpcSubnet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaVpcSubnetCreate,
+		ReadContext:   resourceVcfaVpcSubnetRead,
+		UpdateContext: resourceVcfaVpcSubnetUpdate,
+		DeleteContext: resourceVcfaVpcSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaVpcSubnetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Name of %s", labelVcfaVpcSubnet),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Description of %s", labelVcfaVpcSubnet),
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the %s this %s belongs to", labelVcfaVpc, labelVcfaVpcSubnet),
+			},
+			"access_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: fmt.Sprintf("Access mode of %s. One of '%s'. Public subnets get their addresses from the public CIDRs of the %s, "+
+					"the others from its private CIDRs", labelVcfaVpcSubnet, strings.Join(vpcSubnetAccessModes, "', '"), labelVcfaVpc),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(vpcSubnetAccessModes, false)),
+			},
+			"ip_cidrs": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ipv4_subnet_size"},
+				Description:   fmt.Sprintf("CIDR blocks of %s. They are allocated from the CIDRs of the %s when not set", labelVcfaVpcSubnet, labelVcfaVpc),
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsCIDR),
+				},
+			},
+			"ipv4_subnet_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"ip_cidrs"},
+				Description:      fmt.Sprintf("Number of IP addresses of %s, as a power of 2, when its CIDR is allocated automatically", labelVcfaVpcSubnet),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntInSlice([]int{16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768, 65536})),
+			},
+			"dhcp_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("DHCP mode of %s. One of '%s'. Defaults to the mode set by NSX for the access mode",
+					labelVcfaVpcSubnet, strings.Join(vpcSubnetDhcpModes, "', '")),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(vpcSubnetDhcpModes, false)),
+			},
+			"dhcp_dns_servers": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				Description:  "DNS servers handed out by the DHCP server. Only used with 'DHCP_SERVER' mode",
+				RequiredWith: []string{"dhcp_mode"},
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPAddress),
+				},
+			},
+			"dhcp_lease_time": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Lease time of the DHCP server in seconds. Only used with 'DHCP_SERVER' mode",
+				RequiredWith:     []string{"dhcp_mode"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(60)),
+			},
+			"gateway_addresses": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Gateway addresses of %s", labelVcfaVpcSubnet),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"backing_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID for the matching Subnet in NSX",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Status of %s", labelVcfaVpcSubnet),
+			},
+		},
+	}
+}
+
+func resourceVcfaVpcSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("vpc_id").(string))
+	defer unlock()
+
+	c := crudConfig[*tmVpcSubnet, tmVpcSubnetConfig]{
+		entityLabel:      labelVcfaVpcSubnet,
+		getTypeFunc:      getVpcSubnetType,
+		stateStoreFunc:   setVpcSubnetData,
+		createFunc:       tmClient.createTmVpcSubnet,
+		getEntityFunc:    tmClient.getTmVpcSubnetById,
+		resourceReadFunc: resourceVcfaVpcSubnetRead,
+	}
+	return createResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("vpc_id").(string))
+	defer unlock()
+
+	c := crudConfig[*tmVpcSubnet, tmVpcSubnetConfig]{
+		entityLabel:      labelVcfaVpcSubnet,
+		getTypeFunc:      getVpcSubnetType,
+		getEntityFunc:    tmClient.getTmVpcSubnetById,
+		resourceReadFunc: resourceVcfaVpcSubnetRead,
+	}
+
+	return updateResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	c := crudConfig[*tmVpcSubnet, tmVpcSubnetConfig]{
+		entityLabel:    labelVcfaVpcSubnet,
+		getEntityFunc:  tmClient.getTmVpcSubnetById,
+		stateStoreFunc: setVpcSubnetData,
+	}
+	return readResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("vpc_id").(string))
+	defer unlock()
+
+	c := crudConfig[*tmVpcSubnet, tmVpcSubnetConfig]{
+		entityLabel:   labelVcfaVpcSubnet,
+		getEntityFunc: tmClient.getTmVpcSubnetById,
+	}
+
+	return deleteResource(ctx, d, meta, c)
+}
+
+func resourceVcfaVpcSubnetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	id := strings.Split(d.Id(), ImportSeparator)
+	if len(id) != 4 {
+		return nil, fmt.Errorf("ID syntax should be <%s name>%s<%s name>%s<%s name>%s<%s name>", labelVcfaOrg, ImportSeparator,
+			labelVcfaRegionalNetworkingSetting, ImportSeparator, labelVcfaVpc, ImportSeparator, labelVcfaVpcSubnet)
+	}
+
+	rns, err := getRegionalNetworkingSettingByOrgName(tmClient, id[0], id[1])
+	if err != nil {
+		return nil, err
+	}
+
+	vpc, err := tmClient.getTmVpcByNameAndRegionalNetworkingId(id[2], rns.TmRegionalNetworkingSetting.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s' within %s '%s': %s", labelVcfaVpc, id[2], labelVcfaRegionalNetworkingSetting, id[1], err)
+	}
+
+	subnet, err := tmClient.getTmVpcSubnetByNameAndVpcId(id[3], vpc.TmVpc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s' within %s '%s': %s", labelVcfaVpcSubnet, id[3], labelVcfaVpc, id[2], err)
+	}
+
+	dSet(d, "vpc_id", vpc.TmVpc.ID)
+	d.SetId(subnet.TmVpcSubnet.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getVpcSubnetType(_ *VCDClient, d *schema.ResourceData) (*tmVpcSubnetConfig, error) {
+	t := &tmVpcSubnetConfig{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		VpcRef:         types.OpenApiReference{ID: d.Get("vpc_id").(string)},
+		AccessMode:     d.Get("access_mode").(string),
+		IpCidrBlocks:   convertSchemaSetToSliceOfStrings(d.Get("ip_cidrs").(*schema.Set)),
+		Ipv4SubnetSize: d.Get("ipv4_subnet_size").(int),
+	}
+
+	dhcpMode := d.Get("dhcp_mode").(string)
+	if dhcpMode != "" {
+		t.DhcpConfig = &tmVpcSubnetDhcpConfig{
+			Mode:       dhcpMode,
+			DnsServers: convertTypeListToSliceOfStrings(d.Get("dhcp_dns_servers").([]interface{})),
+			LeaseTime:  d.Get("dhcp_lease_time").(int),
+		}
+	}
+
+	return t, nil
+}
+
+func setVpcSubnetData(_ *VCDClient, d *schema.ResourceData, s *tmVpcSubnet) error {
+	if s == nil || s.TmVpcSubnet == nil {
+		return fmt.Errorf("nil %s received", labelVcfaVpcSubnet)
+	}
+
+	d.SetId(s.TmVpcSubnet.ID)
+	dSet(d, "name", s.TmVpcSubnet.Name)
+	dSet(d, "description", s.TmVpcSubnet.Description)
+	dSet(d, "vpc_id", s.TmVpcSubnet.VpcRef.ID)
+	dSet(d, "access_mode", s.TmVpcSubnet.AccessMode)
+	dSet(d, "ipv4_subnet_size", s.TmVpcSubnet.Ipv4SubnetSize)
+	dSet(d, "backing_id", s.TmVpcSubnet.BackingId)
+	dSet(d, "status", s.TmVpcSubnet.Status)
+
+	err := d.Set("ip_cidrs", s.TmVpcSubnet.IpCidrBlocks)
+	if err != nil {
+		return fmt.Errorf("error storing 'ip_cidrs': %s", err)
+	}
+	err = d.Set("gateway_addresses", s.TmVpcSubnet.GatewayAddresses)
+	if err != nil {
+		return fmt.Errorf("error storing 'gateway_addresses': %s", err)
+	}
+
+	if s.TmVpcSubnet.DhcpConfig != nil {
+		dSet(d, "dhcp_mode", s.TmVpcSubnet.DhcpConfig.Mode)
+		dSet(d, "dhcp_lease_time", s.TmVpcSubnet.DhcpConfig.LeaseTime)
+		err = d.Set("dhcp_dns_servers", s.TmVpcSubnet.DhcpConfig.DnsServers)
+	} else {
+		dSet(d, "dhcp_mode", "")
+		dSet(d, "dhcp_lease_time", 0)
+		err = d.Set("dhcp_dns_servers", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("error storing 'dhcp_dns_servers': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+//go:build tm || org || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVcfaVpc(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	ipSpaceHcl, ipSpaceHclRef := getIpSpaceHcl(t, regionHclRef, "1", "1")
+	providerGatewayHcl, providerGatewayHclRef := getProviderGatewayHcl(t, regionHclRef, ipSpaceHclRef)
+
+	k8sCompliantName := strings.ReplaceAll(strings.ToLower(t.Name()), "_", "-")
+
+	var params = StringMap{
+		"Testname":          t.Name(),
+		"VpcName":           k8sCompliantName,
+		"RegionId":          fmt.Sprintf("%s.id", regionHclRef),
+		"ProviderGatewayId": fmt.Sprintf("%s.id", providerGatewayHclRef),
+		"Tags":              "tm org",
+	}
+	testParamsNotEmpty(t, params)
+
+	// TODO: TM: There shouldn't be a need to create `preRequisites` separately, but region
+	// creation fails if it is spawned instantly after adding vCenter, therefore this extra step
+	// give time (with additional 'refresh' and 'refresh storage policies' operations on vCenter)
+	skipBinaryTest := "# skip-binary-test: prerequisite buildup for acceptance tests"
+	configText0 := templateFill(vCenterHcl+nsxManagerHcl+skipBinaryTest, params)
+	params["FuncName"] = t.Name() + "-step0"
+
+	preRequisites := vCenterHcl + nsxManagerHcl + regionHcl + ipSpaceHcl + providerGatewayHcl
+	configText1 := templateFill(preRequisites+testAccVcfaVpcStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(preRequisites+testAccVcfaVpcStep2, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	cachedVpcId := &testCachedFieldValue{}
+	cachedSubnetId := &testCachedFieldValue{}
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText0,
+			},
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					cachedVpcId.cacheTestResourceFieldValue("vcfa_vpc.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_vpc.test", "name", k8sCompliantName),
+					resource.TestCheckResourceAttr("vcfa_vpc.test", "description", "description test"),
+					resource.TestCheckResourceAttrPair("vcfa_vpc.test", "org_id", "vcfa_org.test", "id"),
+					resource.TestCheckResourceAttrPair("vcfa_vpc.test", "region_id", "vcfa_org_regional_networking.test", "region_id"),
+					resource.TestCheckResourceAttrSet("vcfa_vpc.test", "connectivity_profile_name"),
+					resource.TestCheckResourceAttrSet("vcfa_vpc.test", "backing_id"),
+					resource.TestCheckResourceAttrSet("vcfa_vpc.test", "status"),
+					resource.TestCheckResourceAttr("vcfa_vpc.test", "private_cidrs.#", "1"),
+					resource.TestCheckTypeSetElemAttr("vcfa_vpc.test", "private_cidrs.*", "172.16.0.0/16"),
+
+					cachedSubnetId.cacheTestResourceFieldValue("vcfa_vpc_subnet.private", "id"),
+					resource.TestCheckResourceAttrPair("vcfa_vpc_subnet.private", "vpc_id", "vcfa_vpc.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.private", "access_mode", "PRIVATE"),
+					resource.TestCheckTypeSetElemAttr("vcfa_vpc_subnet.private", "ip_cidrs.*", "172.16.1.0/24"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.private", "dhcp_mode", "DHCP_SERVER"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.private", "dhcp_dns_servers.#", "1"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.private", "dhcp_dns_servers.0", "8.8.8.8"),
+					resource.TestCheckResourceAttrSet("vcfa_vpc_subnet.private", "backing_id"),
+
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.isolated", "access_mode", "ISOLATED"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.isolated", "ipv4_subnet_size", "64"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.isolated", "ip_cidrs.#", "1"),
+				),
+			},
+			{ // Update - the VPC and the subnet are updated in place
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					cachedVpcId.testCheckCachedResourceFieldValue("vcfa_vpc.test", "id"),
+					resource.TestCheckResourceAttr("vcfa_vpc.test", "name", k8sCompliantName+"-upd"),
+					resource.TestCheckResourceAttr("vcfa_vpc.test", "description", "description test - update"),
+					resource.TestCheckResourceAttr("vcfa_vpc.test", "private_cidrs.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vcfa_vpc.test", "private_cidrs.*", "172.17.0.0/16"),
+
+					cachedSubnetId.testCheckCachedResourceFieldValue("vcfa_vpc_subnet.private", "id"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.private", "name", k8sCompliantName+"-private-upd"),
+					resource.TestCheckResourceAttr("vcfa_vpc_subnet.private", "dhcp_mode", "DHCP_DEACTIVATED"),
+				),
+			},
+			{
+				ResourceName:      "vcfa_vpc.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     params["Testname"].(string) + ImportSeparator + params["Testname"].(string) + ImportSeparator + k8sCompliantName + "-upd",
+			},
+			{
+				ResourceName:      "vcfa_vpc_subnet.private",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId: params["Testname"].(string) + ImportSeparator + params["Testname"].(string) + ImportSeparator +
+					k8sCompliantName + "-upd" + ImportSeparator + k8sCompliantName + "-private-upd",
+			},
+		},
+	})
+}
+
+const testAccVcfaVpcPrerequisites = testAccVcfaOrgRegionalNetworkingStep1
+
+const testAccVcfaVpcStep1 = testAccVcfaVpcPrerequisites + `
+resource "vcfa_vpc" "test" {
+  name                       = "{{.VpcName}}"
+  description                = "description test"
+  org_regional_networking_id = vcfa_org_regional_networking.test.id
+  private_cidrs              = ["172.16.0.0/16"]
+}
+
+resource "vcfa_vpc_subnet" "private" {
+  name             = "{{.VpcName}}-private"
+  vpc_id           = vcfa_vpc.test.id
+  access_mode      = "PRIVATE"
+  ip_cidrs         = ["172.16.1.0/24"]
+  dhcp_mode        = "DHCP_SERVER"
+  dhcp_dns_servers = ["8.8.8.8"]
+}
+
+resource "vcfa_vpc_subnet" "isolated" {
+  name             = "{{.VpcName}}-isolated"
+  vpc_id           = vcfa_vpc.test.id
+  access_mode      = "ISOLATED"
+  ipv4_subnet_size = 64
+}
+`
+
+const testAccVcfaVpcStep2 = testAccVcfaVpcPrerequisites + `
+resource "vcfa_vpc" "test" {
+  name                       = "{{.VpcName}}-upd"
+  description                = "description test - update"
+  org_regional_networking_id = vcfa_org_regional_networking.test.id
+  private_cidrs              = ["172.16.0.0/16", "172.17.0.0/16"]
+}
+
+resource "vcfa_vpc_subnet" "private" {
+  name        = "{{.VpcName}}-private-upd"
+  vpc_id      = vcfa_vpc.test.id
+  access_mode = "PRIVATE"
+  ip_cidrs    = ["172.16.1.0/24"]
+  dhcp_mode   = "DHCP_DEACTIVATED"
+}
+
+resource "vcfa_vpc_subnet" "isolated" {
+  name             = "{{.VpcName}}-isolated"
+  vpc_id           = vcfa_vpc.test.id
+  access_mode      = "ISOLATED"
+  ipv4_subnet_size = 64
+}
+`
@@ -0,0 +1,265 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+// This file contains the types and the CRUD methods of VPCs and VPC Subnets, as the pinned version of
+// go-vcloud-director does not support them yet. They follow the layout of the Tm* types of go-vcloud-director,
+// so that they can be moved there as they are.
+// TODO: TM: Replace with the VPC and VPC Subnet types and methods of go-vcloud-director once it supports them
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const (
+	openApiEndpointTmVpcs       = "vpcs/"
+	openApiEndpointTmVpcSubnets = "vpcSubnets/"
+)
+
+// tmVpcConfig is the OpenAPI payload of a VPC of an Organization in a Region
+type tmVpcConfig struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// The Regional Networking Setting this VPC belongs to, which defines its Organization and Region
+	RegionalNetworkingSettingRef types.OpenApiReference `json:"regionalNetworkingSettingRef"`
+	OrgRef                       types.OpenApiReference `json:"orgRef,omitempty"`
+	RegionRef                    types.OpenApiReference `json:"regionRef,omitempty"`
+
+	// Name of the VPC connectivity profile. The default profile of the Regional Networking Setting
+	// is used when it is empty
+	VpcConnectivityProfileName string `json:"vpcConnectivityProfileName,omitempty"`
+
+	PrivateCidrBlocks []string `json:"privateCidrBlocks,omitempty"`
+	PublicCidrBlocks  []string `json:"publicCidrBlocks,omitempty"`
+
+	// ID of the matching VPC in NSX
+	BackingId string `json:"backingId,omitempty"`
+
+	// Status represents current status of the networking entity (PENDING, CONFIGURING, REALIZED,
+	// REALIZATION_FAILED or UNKNOWN)
+	Status string `json:"status,omitempty"`
+}
+
+// tmVpc wraps a VPC so that it can be managed with the generic CRUD functions
+type tmVpc struct {
+	TmVpc    *tmVpcConfig
+	tmClient *VCDClient
+}
+
+func (tmClient *VCDClient) createTmVpc(config *tmVpcConfig) (*tmVpc, error) {
+	urlRef, err := tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf + openApiEndpointTmVpcs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &tmVpc{TmVpc: &tmVpcConfig{}, tmClient: tmClient}
+	err = tmClient.Client.OpenApiPostItem(tmClient.Client.APIVersion, urlRef, nil, config, result.TmVpc, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s: %s", labelVcfaVpc, err)
+	}
+	return result, nil
+}
+
+func (tmClient *VCDClient) getTmVpcById(id string) (*tmVpc, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty %s ID", labelVcfaVpc)
+	}
+
+	urlRef, err := tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf+openApiEndpointTmVpcs, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &tmVpc{TmVpc: &tmVpcConfig{}, tmClient: tmClient}
+	err = tmClient.Client.OpenApiGetItem(tmClient.Client.APIVersion, urlRef, nil, result.TmVpc, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (tmClient *VCDClient) getTmVpcByNameAndRegionalNetworkingId(name, regionalNetworkingId string) (*tmVpc, error) {
+	urlRef, err := tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf + openApiEndpointTmVpcs)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", fmt.Sprintf("name==%s;regionalNetworkingSettingRef.id==%s", name, regionalNetworkingId))
+
+	var vpcs []*tmVpcConfig
+	err = tmClient.Client.OpenApiGetAllItems(tmClient.Client.APIVersion, urlRef, queryParams, &vpcs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(vpcs) == 0 {
+		return nil, fmt.Errorf("%s: %s '%s' not found", govcd.ErrorEntityNotFound, labelVcfaVpc, name)
+	}
+	if len(vpcs) > 1 {
+		return nil, fmt.Errorf("found %d %ss with name '%s'", len(vpcs), labelVcfaVpc, name)
+	}
+	return &tmVpc{TmVpc: vpcs[0], tmClient: tmClient}, nil
+}
+
+func (v *tmVpc) Update(config *tmVpcConfig) (*tmVpc, error) {
+	urlRef, err := v.tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf+openApiEndpointTmVpcs, v.TmVpc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	config.ID = v.TmVpc.ID
+	result := &tmVpc{TmVpc: &tmVpcConfig{}, tmClient: v.tmClient}
+	err = v.tmClient.Client.OpenApiPutItem(v.tmClient.Client.APIVersion, urlRef, nil, config, result.TmVpc, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error updating %s: %s", labelVcfaVpc, err)
+	}
+	return result, nil
+}
+
+func (v *tmVpc) Delete() error {
+	urlRef, err := v.tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf+openApiEndpointTmVpcs, v.TmVpc.ID)
+	if err != nil {
+		return err
+	}
+
+	err = v.tmClient.Client.OpenApiDeleteItem(v.tmClient.Client.APIVersion, urlRef, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting %s: %s", labelVcfaVpc, err)
+	}
+	return nil
+}
+
+// tmVpcSubnetConfig is the OpenAPI payload of a Subnet of a VPC
+type tmVpcSubnetConfig struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	// The VPC this Subnet belongs to
+	VpcRef types.OpenApiReference `json:"vpcRef"`
+
+	// AccessMode is one of PUBLIC, PRIVATE or ISOLATED
+	AccessMode string `json:"accessMode"`
+
+	// IpCidrBlocks are the CIDRs of the Subnet. When empty, a CIDR of Ipv4SubnetSize addresses is
+	// allocated from the VPC
+	IpCidrBlocks   []string `json:"ipCidrBlocks,omitempty"`
+	Ipv4SubnetSize int      `json:"ipv4SubnetSize,omitempty"`
+
+	DhcpConfig *tmVpcSubnetDhcpConfig `json:"dhcpConfig,omitempty"`
+
+	GatewayAddresses []string `json:"gatewayAddresses,omitempty"`
+
+	// ID of the matching Subnet in NSX
+	BackingId string `json:"backingId,omitempty"`
+
+	// Status represents current status of the networking entity (PENDING, CONFIGURING, REALIZED,
+	// REALIZATION_FAILED or UNKNOWN)
+	Status string `json:"status,omitempty"`
+}
+
+// tmVpcSubnetDhcpConfig is the DHCP configuration of a Subnet of a VPC
+type tmVpcSubnetDhcpConfig struct {
+	// Mode is one of DHCP_SERVER, DHCP_RELAY or DHCP_DEACTIVATED
+	Mode       string   `json:"mode"`
+	DnsServers []string `json:"dnsServers,omitempty"`
+	// LeaseTime in seconds
+	LeaseTime int `json:"leaseTime,omitempty"`
+}
+
+// tmVpcSubnet wraps a VPC Subnet so that it can be managed with the generic CRUD functions
+type tmVpcSubnet struct {
+	TmVpcSubnet *tmVpcSubnetConfig
+	tmClient    *VCDClient
+}
+
+func (tmClient *VCDClient) createTmVpcSubnet(config *tmVpcSubnetConfig) (*tmVpcSubnet, error) {
+	urlRef, err := tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf + openApiEndpointTmVpcSubnets)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &tmVpcSubnet{TmVpcSubnet: &tmVpcSubnetConfig{}, tmClient: tmClient}
+	err = tmClient.Client.OpenApiPostItem(tmClient.Client.APIVersion, urlRef, nil, config, result.TmVpcSubnet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s: %s", labelVcfaVpcSubnet, err)
+	}
+	return result, nil
+}
+
+func (tmClient *VCDClient) getTmVpcSubnetById(id string) (*tmVpcSubnet, error) {
+	if id == "" {
+		return nil, fmt.Errorf("empty %s ID", labelVcfaVpcSubnet)
+	}
+
+	urlRef, err := tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf+openApiEndpointTmVpcSubnets, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &tmVpcSubnet{TmVpcSubnet: &tmVpcSubnetConfig{}, tmClient: tmClient}
+	err = tmClient.Client.OpenApiGetItem(tmClient.Client.APIVersion, urlRef, nil, result.TmVpcSubnet, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (tmClient *VCDClient) getTmVpcSubnetByNameAndVpcId(name, vpcId string) (*tmVpcSubnet, error) {
+	urlRef, err := tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf + openApiEndpointTmVpcSubnets)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", fmt.Sprintf("name==%s;vpcRef.id==%s", name, vpcId))
+
+	var subnets []*tmVpcSubnetConfig
+	err = tmClient.Client.OpenApiGetAllItems(tmClient.Client.APIVersion, urlRef, queryParams, &subnets, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("%s: %s '%s' not found", govcd.ErrorEntityNotFound, labelVcfaVpcSubnet, name)
+	}
+	if len(subnets) > 1 {
+		return nil, fmt.Errorf("found %d %ss with name '%s'", len(subnets), labelVcfaVpcSubnet, name)
+	}
+	return &tmVpcSubnet{TmVpcSubnet: subnets[0], tmClient: tmClient}, nil
+}
+
+func (s *tmVpcSubnet) Update(config *tmVpcSubnetConfig) (*tmVpcSubnet, error) {
+	urlRef, err := s.tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf+openApiEndpointTmVpcSubnets, s.TmVpcSubnet.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	config.ID = s.TmVpcSubnet.ID
+	result := &tmVpcSubnet{TmVpcSubnet: &tmVpcSubnetConfig{}, tmClient: s.tmClient}
+	err = s.tmClient.Client.OpenApiPutItem(s.tmClient.Client.APIVersion, urlRef, nil, config, result.TmVpcSubnet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error updating %s: %s", labelVcfaVpcSubnet, err)
+	}
+	return result, nil
+}
+
+func (s *tmVpcSubnet) Delete() error {
+	urlRef, err := s.tmClient.Client.OpenApiBuildEndpoint(types.OpenApiPathVcf+openApiEndpointTmVpcSubnets, s.TmVpcSubnet.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.tmClient.Client.OpenApiDeleteItem(s.tmClient.Client.APIVersion, urlRef, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting %s: %s", labelVcfaVpcSubnet, err)
+	}
+	return nil
+}